@@ -0,0 +1,414 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+	"golang.org/x/text/language"
+)
+
+// arbRuleID is the resource attribute that records the message ID of an ARB key.
+const arbRuleID = "x-rule-id"
+
+// arbField is the placeholder attribute that records the template field when it
+// is not the placeholder name with its first letter in upper case.
+const arbField = "x-field"
+
+var fieldAction = regexp.MustCompile(`^\{\{\s*\.([A-Za-z_][A-Za-z0-9_]*)\s*\}\}$`)
+
+// arbKey returns the camelCase ARB resource key of a message ID, e.g.
+// "string.min_len" becomes "stringMinLen".
+func arbKey(id string) string {
+	var b strings.Builder
+	upper := false
+	for _, r := range id {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upper = b.Len() > 0
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// placeholderName returns the argument name of a template field in frontend and
+// ICU message syntax, e.g. "Value" becomes "value".
+func placeholderName(field string) string {
+	r, n := utf8.DecodeRuneInString(field)
+	return string(unicode.ToLower(r)) + field[n:]
+}
+
+func arbDefaultField(name string) string {
+	r, n := utf8.DecodeRuneInString(name)
+	return string(unicode.ToUpper(r)) + name[n:]
+}
+
+// convertTemplate rewrites src for another message syntax: literal text is passed
+// to escape and {{.Field}} actions to arg. Other actions have no equivalent.
+func convertTemplate(src string, escape func(string) string, arg func(field string) (string, error)) (string, error) {
+	var b strings.Builder
+	last := 0
+	for _, loc := range placeholder.FindAllStringIndex(src, -1) {
+		b.WriteString(escape(src[last:loc[0]]))
+		m := fieldAction.FindStringSubmatch(src[loc[0]:loc[1]])
+		if m == nil {
+			return "", fmt.Errorf("template action %s has no equivalent", src[loc[0]:loc[1]])
+		}
+		s, err := arg(m[1])
+		if err != nil {
+			return "", err
+		}
+		b.WriteString(s)
+		last = loc[1]
+	}
+	b.WriteString(escape(src[last:]))
+	return b.String(), nil
+}
+
+// icuMessage converts a template to ICU MessageFormat, adding the fields it
+// uses to fields. Characters in special are quoted in literal text.
+func icuMessage(src string, fields map[string]string, special string) (string, error) {
+	escape := func(s string) string { return icuEscape(s, special) }
+	return convertTemplate(src, escape, func(field string) (string, error) {
+		name := placeholderName(field)
+		if f, ok := fields[name]; ok && f != field {
+			return "", fmt.Errorf("fields %s and %s map to the same placeholder {%s}", f, field, name)
+		}
+		fields[name] = field
+		return "{" + name + "}", nil
+	})
+}
+
+// icuSyntax is quoted in all ICU text; # is special in plural forms only.
+const icuSyntax = "{}"
+
+// icuEscape doubles apostrophes and quotes each run of characters in special.
+func icuEscape(s string, special string) string {
+	var b strings.Builder
+	quoted := false
+	for _, r := range s {
+		switch {
+		case strings.ContainsRune(special, r):
+			if !quoted {
+				b.WriteByte('\'')
+				quoted = true
+			}
+		case quoted:
+			b.WriteByte('\'')
+			quoted = false
+		}
+		if r == '\'' {
+			b.WriteByte('\'')
+		}
+		b.WriteRune(r)
+	}
+	if quoted {
+		b.WriteByte('\'')
+	}
+	return b.String()
+}
+
+// icuPlural converts a plural message to an ICU plural argument on count.
+func icuPlural(m *i18n.Message, fields map[string]string, special string) (string, error) {
+	var b strings.Builder
+	b.WriteString("{count, plural,")
+	for _, category := range cldrCategories {
+		form := pluralForm(m, category)
+		if form == "" {
+			continue
+		}
+		s, err := icuMessage(form, fields, special+"#")
+		if err != nil {
+			return "", err
+		}
+		fmt.Fprintf(&b, " %s{%s}", category, s)
+	}
+	b.WriteString("}")
+	return b.String(), nil
+}
+
+// exportARB returns an app_<lang>.arb per language, including the source. Each
+// resource records its message ID and the template field of every placeholder.
+func exportARB(cat *localeCatalog, docs map[string]string) ([]outputFile, error) {
+	keys := map[string]string{}
+	for _, id := range cat.ids() {
+		key := arbKey(id)
+		if other, ok := keys[key]; ok {
+			return nil, fmt.Errorf("message IDs %s and %s have the same ARB key %s", other, id, key)
+		}
+		keys[key] = id
+	}
+	var files []outputFile
+	for _, tag := range append([]language.Tag{cat.source}, cat.langs...) {
+		var b bytes.Buffer
+		locale := strings.ReplaceAll(tag.String(), "-", "_")
+		fmt.Fprintf(&b, "{\n  \"@@locale\": %s", arbJSON(locale))
+		for _, id := range cat.ids() {
+			msg := cat.translation(tag, id)
+			if tag == cat.source {
+				msg = cat.messages[tag][id]
+			}
+			if msg == nil {
+				continue
+			}
+			fields := map[string]string{}
+			var text string
+			var err error
+			if isPlural(msg) {
+				text, err = icuPlural(msg, fields, icuSyntax)
+			} else {
+				text, err = icuMessage(msg.Other, fields, icuSyntax)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("%s: %s: %w", tag, id, err)
+			}
+			key := arbKey(id)
+			fmt.Fprintf(&b, ",\n  %s: %s,\n  %s: {", arbJSON(key), arbJSON(text), arbJSON("@"+key))
+			if doc := docs[id]; doc != "" && tag == cat.source {
+				fmt.Fprintf(&b, "\n    \"description\": %s,", arbJSON(doc))
+			}
+			if len(fields) > 0 || isPlural(msg) {
+				b.WriteString("\n    \"placeholders\": {")
+				names := make([]string, 0, len(fields))
+				for name := range fields {
+					names = append(names, name)
+				}
+				if _, ok := fields["count"]; isPlural(msg) && !ok {
+					names = append(names, "count")
+				}
+				sort.Strings(names)
+				for i, name := range names {
+					if i > 0 {
+						b.WriteString(",")
+					}
+					var attrs []string
+					if name == "count" && isPlural(msg) {
+						attrs = append(attrs, `"type": "num"`)
+					}
+					if f, ok := fields[name]; ok && f != arbDefaultField(name) {
+						attrs = append(attrs, arbJSON(arbField)+": "+arbJSON(f))
+					}
+					fmt.Fprintf(&b, "\n      %s: {%s}", arbJSON(name), strings.Join(attrs, ", "))
+				}
+				b.WriteString("\n    },")
+			}
+			fmt.Fprintf(&b, "\n    %s: %s\n  }", arbJSON(arbRuleID), arbJSON(id))
+		}
+		b.WriteString("\n}\n")
+		files = append(files, outputFile{name: "app_" + locale + ".arb", data: b.Bytes()})
+	}
+	return files, nil
+}
+
+func arbJSON(s string) string {
+	var b bytes.Buffer
+	enc := json.NewEncoder(&b)
+	enc.SetEscapeHTML(false)
+	enc.Encode(s)
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+type arbResource struct {
+	RuleID       string                     `json:"x-rule-id"`
+	Placeholders map[string]json.RawMessage `json:"placeholders"`
+}
+
+// importARB decodes an ARB file. Resources without an x-rule-id attribute are
+// skipped with a warning, since their message ID is unknown.
+func importARB(e *env, path string, buf []byte) (language.Tag, []*i18n.Message, error) {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(buf, &raw); err != nil {
+		return language.Und, nil, fmt.Errorf("%s: %w", path, err)
+	}
+	var locale string
+	if err := json.Unmarshal(raw["@@locale"], &locale); err != nil || locale == "" {
+		return language.Und, nil, fmt.Errorf("%s: missing @@locale", path)
+	}
+	tag, err := language.Parse(strings.ReplaceAll(locale, "_", "-"))
+	if err != nil {
+		return language.Und, nil, fmt.Errorf("%s: %w", path, err)
+	}
+	keys := make([]string, 0, len(raw))
+	for key := range raw {
+		if !strings.HasPrefix(key, "@") {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	var msgs []*i18n.Message
+	for _, key := range keys {
+		var text string
+		if err := json.Unmarshal(raw[key], &text); err != nil {
+			return language.Und, nil, fmt.Errorf("%s: %s: %w", path, key, err)
+		}
+		var res arbResource
+		if meta, ok := raw["@"+key]; ok {
+			if err := json.Unmarshal(meta, &res); err != nil {
+				return language.Und, nil, fmt.Errorf("%s: @%s: %w", path, key, err)
+			}
+		}
+		if res.RuleID == "" {
+			fmt.Fprintf(e.stderr, "pvt: %s: %s: no %s, skipped\n", path, key, arbRuleID)
+			continue
+		}
+		fields := map[string]string{}
+		for name, attrs := range res.Placeholders {
+			var a map[string]any
+			if err := json.Unmarshal(attrs, &a); err != nil {
+				return language.Und, nil, fmt.Errorf("%s: @%s: placeholder %s: %w", path, key, name, err)
+			}
+			if f, ok := a[arbField].(string); ok {
+				fields[name] = f
+			}
+		}
+		msg, err := parseICU(text, fields)
+		if err != nil {
+			return language.Und, nil, fmt.Errorf("%s: %s: %w", path, key, err)
+		}
+		msg.ID = res.RuleID
+		msgs = append(msgs, msg)
+	}
+	return tag, msgs, nil
+}
+
+// parseICU converts an ICU message, either plain or a single plural argument, back
+// to a message with templates.
+func parseICU(s string, fields map[string]string) (*i18n.Message, error) {
+	p := &icuParser{s: s, fields: fields}
+	msg := &i18n.Message{}
+	if rest, ok := strings.CutPrefix(strings.TrimSpace(s), "{"); ok {
+		if arg, body, ok := strings.Cut(rest, ","); ok {
+			if kind, forms, ok := strings.Cut(body, ","); ok && strings.TrimSpace(kind) == "plural" && !strings.ContainsAny(arg, "{}'") {
+				p.s = forms
+				if err := p.plural(msg); err != nil {
+					return nil, err
+				}
+				return msg, nil
+			}
+		}
+	}
+	text, err := p.text(false)
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.s) {
+		return nil, fmt.Errorf("unexpected %q at offset %d", p.s[p.pos], p.pos)
+	}
+	msg.Other = text
+	return msg, nil
+}
+
+type icuParser struct {
+	s      string
+	pos    int
+	fields map[string]string
+}
+
+// plural parses "category{text} ...}" into the plural forms of msg.
+func (p *icuParser) plural(msg *i18n.Message) error {
+	for {
+		p.skipSpace()
+		if p.pos >= len(p.s) {
+			return fmt.Errorf("unterminated plural")
+		}
+		if p.s[p.pos] == '}' {
+			p.pos++
+			p.skipSpace()
+			if p.pos < len(p.s) {
+				return fmt.Errorf("text after plural argument")
+			}
+			if msg.Other == "" {
+				return fmt.Errorf("plural without other form")
+			}
+			return nil
+		}
+		start := p.pos
+		for p.pos < len(p.s) && p.s[p.pos] != '{' && p.s[p.pos] != ' ' {
+			p.pos++
+		}
+		category := p.s[start:p.pos]
+		switch category {
+		case "zero", "one", "two", "few", "many", "other":
+		default:
+			return fmt.Errorf("unsupported plural selector %q", category)
+		}
+		p.skipSpace()
+		if p.pos >= len(p.s) || p.s[p.pos] != '{' {
+			return fmt.Errorf("missing form for %s", category)
+		}
+		p.pos++
+		text, err := p.text(true)
+		if err != nil {
+			return err
+		}
+		setPluralForm(msg, category, text)
+	}
+}
+
+func (p *icuParser) skipSpace() {
+	for p.pos < len(p.s) && unicode.IsSpace(rune(p.s[p.pos])) {
+		p.pos++
+	}
+}
+
+// text parses literal text and {name} arguments up to the end of the input, or
+// up to and including the closing brace when nested.
+func (p *icuParser) text(nested bool) (string, error) {
+	var b strings.Builder
+	for p.pos < len(p.s) {
+		c := p.s[p.pos]
+		switch {
+		case c == '\'' && strings.HasPrefix(p.s[p.pos:], "''"):
+			b.WriteByte('\'')
+			p.pos += 2
+		case c == '\'' && p.pos+1 < len(p.s) && strings.ContainsRune("{}#|<", rune(p.s[p.pos+1])):
+			end := strings.IndexByte(p.s[p.pos+1:], '\'')
+			if end < 0 {
+				b.WriteString(p.s[p.pos+1:])
+				p.pos = len(p.s)
+				continue
+			}
+			b.WriteString(strings.ReplaceAll(p.s[p.pos+1:p.pos+1+end], "''", "'"))
+			p.pos += end + 2
+		case c == '{':
+			end := strings.IndexByte(p.s[p.pos:], '}')
+			if end < 0 {
+				return "", fmt.Errorf("unterminated argument at offset %d", p.pos)
+			}
+			name := strings.TrimSpace(p.s[p.pos+1 : p.pos+end])
+			if strings.ContainsAny(name, ",{") {
+				return "", fmt.Errorf("unsupported argument {%s}", name)
+			}
+			field, ok := p.fields[name]
+			if !ok {
+				field = arbDefaultField(name)
+			}
+			b.WriteString("{{." + field + "}}")
+			p.pos += end + 1
+		case c == '}':
+			if !nested {
+				return "", fmt.Errorf("unexpected } at offset %d", p.pos)
+			}
+			p.pos++
+			return b.String(), nil
+		default:
+			b.WriteByte(c)
+			p.pos++
+		}
+	}
+	if nested {
+		return "", fmt.Errorf("unterminated plural form")
+	}
+	return b.String(), nil
+}
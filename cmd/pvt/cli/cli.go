@@ -0,0 +1,192 @@
+// Package cli implements the pvt command.
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/jzero-io/protovalidate-translator/translator"
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+)
+
+// command is a pvt subcommand.
+type command struct {
+	name    string
+	summary string
+	run     func(env *env, args []string) error
+}
+
+// env holds the standard streams of a run.
+type env struct {
+	stdin  io.Reader
+	stdout io.Writer
+	stderr io.Writer
+}
+
+// errUsage reports invalid arguments; the message has already been printed.
+var errUsage = errors.New("usage")
+
+func commands() []command {
+	return []command{
+		{"translate", "render messages by rule ID", runTranslate},
+		{"validate", "validate a JSON message and print localized violations", runValidate},
+		{"sync", "add missing and remove obsolete messages in target locales", runSync},
+		{"diff", "show per-ID changes between two versions of a locale file", runDiff},
+		{"export", "convert locales to translation exchange formats", runExport},
+		{"import", "convert translated files back to locale JSON", runImport},
+		{"serve", "serve translations over HTTP/JSON", runServe},
+	}
+}
+
+// Main runs pvt with args (without the program name) and returns the exit code:
+// 0 on success, 1 on failure, validation violations or differences, and 2 on
+// invalid arguments.
+func Main(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) int {
+	err := Run(args, stdin, stdout, stderr)
+	switch {
+	case err == nil || errors.Is(err, flag.ErrHelp):
+		return 0
+	case errors.Is(err, errUsage):
+		return 2
+	case errors.Is(err, errViolations), errors.Is(err, errDiff):
+		return 1
+	default:
+		fmt.Fprintln(stderr, "pvt:", err)
+		return 1
+	}
+}
+
+// Run runs pvt with args (without the program name).
+func Run(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) error {
+	e := &env{stdin: stdin, stdout: stdout, stderr: stderr}
+	if len(args) == 0 || args[0] == "help" || args[0] == "-h" || args[0] == "--help" {
+		e.usage(stdout)
+		return nil
+	}
+	for _, cmd := range commands() {
+		if cmd.name == args[0] {
+			return cmd.run(e, args[1:])
+		}
+	}
+	fmt.Fprintf(stderr, "pvt: unknown command %q\n", args[0])
+	e.usage(stderr)
+	return errUsage
+}
+
+func (e *env) usage(w io.Writer) {
+	fmt.Fprintln(w, "Usage: pvt <command> [flags] [args]")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Commands:")
+	for _, cmd := range commands() {
+		fmt.Fprintf(w, "  %-10s %s\n", cmd.name, cmd.summary)
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, `Run "pvt <command> -h" for the flags of a command.`)
+}
+
+func (e *env) flagSet(name string, usage string) *flag.FlagSet {
+	fs := flag.NewFlagSet("pvt "+name, flag.ContinueOnError)
+	fs.SetOutput(e.stderr)
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: pvt %s %s\n\nFlags:\n", name, usage)
+		fs.PrintDefaults()
+	}
+	return fs
+}
+
+// parse parses flags that may be interleaved with positional arguments and
+// returns the positional arguments.
+func parse(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			if errors.Is(err, flag.ErrHelp) {
+				return nil, err
+			}
+			return nil, errUsage
+		}
+		args = fs.Args()
+		if len(args) == 0 {
+			return positional, nil
+		}
+		if args[0] == "--" {
+			return append(positional, args[1:]...), nil
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+}
+
+// usageError prints msg and the usage of fs.
+func usageError(fs *flag.FlagSet, format string, args ...any) error {
+	fmt.Fprintf(fs.Output(), format+"\n", args...)
+	fs.Usage()
+	return errUsage
+}
+
+// loadBundle returns the bundle in dir, or the default bundle when dir is empty.
+func loadBundle(dir string) (*i18n.Bundle, error) {
+	if dir == "" {
+		return translator.DefaultBundle()
+	}
+	return translator.LoadBundleFromDir(dir, translator.WithRecursive())
+}
+
+// listFlag collects comma-separated or repeated values.
+type listFlag []string
+
+func (l *listFlag) String() string { return strings.Join(*l, ",") }
+
+func (l *listFlag) Set(value string) error {
+	for _, v := range strings.Split(value, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			*l = append(*l, v)
+		}
+	}
+	return nil
+}
+
+// dataFlag collects template data from key=value pairs and JSON objects.
+// Values that are valid JSON (numbers, booleans, arrays) are decoded; others are strings.
+type dataFlag map[string]any
+
+func (d dataFlag) String() string {
+	keys := make([]string, 0, len(d))
+	for k := range d {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	pairs := make([]string, 0, len(keys))
+	for _, k := range keys {
+		pairs = append(pairs, fmt.Sprintf("%s=%v", k, d[k]))
+	}
+	return strings.Join(pairs, ",")
+}
+
+func (d dataFlag) Set(value string) error {
+	if strings.HasPrefix(strings.TrimSpace(value), "{") {
+		var obj map[string]any
+		if err := json.Unmarshal([]byte(value), &obj); err != nil {
+			return fmt.Errorf("invalid JSON object: %w", err)
+		}
+		for k, v := range obj {
+			d[k] = v
+		}
+		return nil
+	}
+	key, raw, ok := strings.Cut(value, "=")
+	if !ok || key == "" {
+		return fmt.Errorf("want key=value or a JSON object, got %q", value)
+	}
+	var v any
+	if err := json.Unmarshal([]byte(raw), &v); err != nil {
+		v = raw
+	}
+	d[key] = v
+	return nil
+}
@@ -0,0 +1,74 @@
+package cli
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+)
+
+func runDiff(e *env, args []string) error {
+	fs := e.flagSet("diff", "<old.json> <new.json>")
+	files, err := parse(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(files) != 2 {
+		return usageError(fs, "want two locale files; use - for stdin")
+	}
+	old, err := e.readLocale(files[0])
+	if err != nil {
+		return err
+	}
+	cur, err := e.readLocale(files[1])
+	if err != nil {
+		return err
+	}
+
+	byID := func(msgs []*i18n.Message) map[string]*i18n.Message {
+		m := make(map[string]*i18n.Message, len(msgs))
+		for _, msg := range msgs {
+			m[msg.ID] = msg
+		}
+		return m
+	}
+	before, after := byID(old), byID(cur)
+	ids := make([]string, 0, len(before)+len(after))
+	for id := range before {
+		ids = append(ids, id)
+	}
+	for id := range after {
+		if _, ok := before[id]; !ok {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+
+	changed := false
+	for _, id := range ids {
+		b, a := before[id], after[id]
+		switch {
+		case b == nil:
+			fmt.Fprintf(e.stdout, "+ %s: %s\n", id, diffText(a))
+		case a == nil:
+			fmt.Fprintf(e.stdout, "- %s: %s\n", id, diffText(b))
+		case !sameText(a, b) || a.Description != b.Description:
+			fmt.Fprintf(e.stdout, "~ %s: %s -> %s\n", id, diffText(b), diffText(a))
+		default:
+			continue
+		}
+		changed = true
+	}
+	if changed {
+		return errDiff
+	}
+	return nil
+}
+
+func diffText(m *i18n.Message) string {
+	text := fmt.Sprintf("%q", messageText(m))
+	if m.Description == untranslated {
+		text += " (untranslated)"
+	}
+	return text
+}
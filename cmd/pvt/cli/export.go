@@ -0,0 +1,250 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+
+	"github.com/jzero-io/protovalidate-translator/translator"
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+	"golang.org/x/text/language"
+)
+
+// localeCatalog holds the messages of every language, merged from the locale files
+// in load order like a bundle.
+type localeCatalog struct {
+	source   language.Tag
+	langs    []language.Tag // translations, sorted; the source is not included
+	messages map[language.Tag]map[string]*i18n.Message
+}
+
+// outputFile is a file written by pvt export or pvt import.
+type outputFile struct {
+	name string
+	data []byte
+}
+
+// loadCatalog loads the locales in dir, or the embedded locales when dir is empty,
+// followed by the locales in each overlay directory.
+func loadCatalog(dir string, overlays []string, source string) (*localeCatalog, error) {
+	var files []*i18n.MessageFile
+	var err error
+	if dir == "" {
+		files, err = translator.LoadMessageFilesFromFS(translator.SelectedLocalesFS, translator.DefaultLocaleDir)
+	} else {
+		files, err = translator.LoadMessageFilesFromDir(dir, translator.WithRecursive())
+	}
+	if err != nil {
+		return nil, err
+	}
+	for _, overlay := range overlays {
+		more, err := translator.LoadMessageFilesFromDir(overlay, translator.WithRecursive())
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, more...)
+	}
+	cat := &localeCatalog{source: language.Make(source), messages: map[language.Tag]map[string]*i18n.Message{}}
+	for _, file := range files {
+		msgs := cat.messages[file.Tag]
+		if msgs == nil {
+			msgs = map[string]*i18n.Message{}
+			cat.messages[file.Tag] = msgs
+			if file.Tag != cat.source {
+				cat.langs = append(cat.langs, file.Tag)
+			}
+		}
+		for _, m := range file.Messages {
+			msgs[m.ID] = m
+		}
+	}
+	if cat.messages[cat.source] == nil {
+		return nil, fmt.Errorf("no %s locale found", source)
+	}
+	sort.Slice(cat.langs, func(i, j int) bool { return cat.langs[i].String() < cat.langs[j].String() })
+	return cat, nil
+}
+
+// ids returns the source message IDs, sorted.
+func (c *localeCatalog) ids() []string {
+	ids := make([]string, 0, len(c.messages[c.source]))
+	for id := range c.messages[c.source] {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// translation returns the translated message for id in tag, or nil when it is
+// missing or marked untranslated.
+func (c *localeCatalog) translation(tag language.Tag, id string) *i18n.Message {
+	if m, ok := c.messages[tag][id]; ok && m.Description != untranslated {
+		return m
+	}
+	return nil
+}
+
+func runExport(e *env, args []string) error {
+	fs := e.flagSet("export", "-format <format> [flags]")
+	format := fs.String("format", "", "output format: po, xliff, arb, i18next, formatjs or vue-i18n")
+	locales := fs.String("locales", "", "locale directory to export instead of the embedded locales")
+	var overlays listFlag
+	fs.Var(&overlays, "overlay", "locale `dir` whose messages are added to the exported ones, e.g. messages of custom rules (repeatable)")
+	source := fs.String("source-lang", translator.DefaultLang, "language of the source messages")
+	protoPath := fs.String("proto", "", "proto file with the rule definitions, e.g. buf/validate/validate.proto; its field documentation becomes translator comments")
+	out := fs.String("out", ".", "output directory")
+	rest, err := parse(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(rest) > 0 {
+		return usageError(fs, "unexpected arguments %v", rest)
+	}
+	cat, err := loadCatalog(*locales, overlays, *source)
+	if err != nil {
+		return err
+	}
+	var docs map[string]string
+	if *protoPath != "" {
+		if docs, err = parseRuleDocs(*protoPath); err != nil {
+			return err
+		}
+	}
+	var files []outputFile
+	switch *format {
+	case "po":
+		files, err = exportPO(cat, docs)
+	case "xliff":
+		files, err = exportXLIFF(cat, docs)
+	case "arb":
+		files, err = exportARB(cat, docs)
+	case "i18next", "formatjs", "vue-i18n":
+		files, err = exportFrontend(cat, *format)
+	case "":
+		return usageError(fs, "missing -format")
+	default:
+		return usageError(fs, "unknown format %q", *format)
+	}
+	if err != nil {
+		return err
+	}
+	return e.writeFiles(*out, files)
+}
+
+func runImport(e *env, args []string) error {
+	fs := e.flagSet("import", "-format <format> [flags] <file>...")
+	format := fs.String("format", "", "input format: po, xliff or arb")
+	out := fs.String("out", ".", "output directory for the <lang>.json locale files")
+	paths, err := parse(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(paths) == 0 {
+		return usageError(fs, "missing input file")
+	}
+	var decode func(e *env, path string, buf []byte) (language.Tag, []*i18n.Message, error)
+	switch *format {
+	case "po":
+		decode = importPO
+	case "xliff":
+		decode = importXLIFF
+	case "arb":
+		decode = importARB
+	case "":
+		return usageError(fs, "missing -format")
+	default:
+		return usageError(fs, "unknown format %q", *format)
+	}
+	var files []outputFile
+	for _, path := range paths {
+		buf, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		tag, msgs, err := decode(e, path, buf)
+		if err != nil {
+			return err
+		}
+		data, err := marshalLocale(msgs)
+		if err != nil {
+			return err
+		}
+		files = append(files, outputFile{name: tag.String() + ".json", data: data})
+	}
+	return e.writeFiles(*out, files)
+}
+
+func (e *env) writeFiles(dir string, files []outputFile) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	for _, f := range files {
+		path := filepath.Join(dir, f.name)
+		if err := os.WriteFile(path, f.data, 0o644); err != nil {
+			return err
+		}
+		fmt.Fprintln(e.stdout, path)
+	}
+	return nil
+}
+
+func isPlural(m *i18n.Message) bool {
+	return m.Zero != "" || m.One != "" || m.Two != "" || m.Few != "" || m.Many != ""
+}
+
+func pluralForm(m *i18n.Message, category string) string {
+	switch category {
+	case "zero":
+		return m.Zero
+	case "one":
+		return m.One
+	case "two":
+		return m.Two
+	case "few":
+		return m.Few
+	case "many":
+		return m.Many
+	default:
+		return m.Other
+	}
+}
+
+func setPluralForm(m *i18n.Message, category string, s string) {
+	switch category {
+	case "zero":
+		m.Zero = s
+	case "one":
+		m.One = s
+	case "two":
+		m.Two = s
+	case "few":
+		m.Few = s
+	case "many":
+		m.Many = s
+	default:
+		m.Other = s
+	}
+}
+
+var placeholder = regexp.MustCompile(`\{\{.*?\}\}`)
+
+// placeholders returns the sorted, distinct template actions in s.
+func placeholders(s string) []string {
+	found := placeholder.FindAllString(s, -1)
+	slices.Sort(found)
+	return slices.Compact(found)
+}
+
+// warnPlaceholders reports translations that drop or add template actions of src.
+func warnPlaceholders(e *env, path string, id string, src string, translations ...string) {
+	want := placeholders(src)
+	for _, t := range translations {
+		if got := placeholders(t); !slices.Equal(got, want) {
+			fmt.Fprintf(e.stderr, "pvt: %s: %s: translation has placeholders %q, source has %q\n", path, id, got, want)
+			return
+		}
+	}
+}
@@ -0,0 +1,152 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+	"golang.org/x/text/language"
+)
+
+// frontendFormats converts a message to the keys and texts of a frontend library.
+var frontendFormats = map[string]struct {
+	nested  bool // dots in message IDs separate nested objects
+	entries func(id string, m *i18n.Message) (map[string]string, error)
+}{
+	"i18next":  {nested: true, entries: i18nextEntries},
+	"formatjs": {nested: false, entries: formatjsEntries},
+	"vue-i18n": {nested: true, entries: vueEntries},
+}
+
+// exportFrontend returns a <lang>.json per language, including the source, in the
+// message syntax of a frontend i18n library. Missing and untranslated messages are
+// left out so that the library falls back to another language.
+func exportFrontend(cat *localeCatalog, format string) ([]outputFile, error) {
+	f := frontendFormats[format]
+	var files []outputFile
+	for _, tag := range append([]language.Tag{cat.source}, cat.langs...) {
+		root := map[string]any{}
+		for _, id := range cat.ids() {
+			msg := cat.translation(tag, id)
+			if tag == cat.source {
+				msg = cat.messages[tag][id]
+			}
+			if msg == nil {
+				continue
+			}
+			entries, err := f.entries(id, msg)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %s: %w", tag, id, err)
+			}
+			for key, text := range entries {
+				if !f.nested {
+					root[key] = text
+				} else if err := setNested(root, key, text); err != nil {
+					return nil, fmt.Errorf("%s: %w", tag, err)
+				}
+			}
+		}
+		var b bytes.Buffer
+		enc := json.NewEncoder(&b)
+		enc.SetEscapeHTML(false)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(root); err != nil {
+			return nil, err
+		}
+		files = append(files, outputFile{name: tag.String() + ".json", data: b.Bytes()})
+	}
+	return files, nil
+}
+
+// setNested stores text at the dot-separated key of root.
+func setNested(root map[string]any, key string, text string) error {
+	parts := strings.Split(key, ".")
+	m := root
+	for i, part := range parts[:len(parts)-1] {
+		switch v := m[part].(type) {
+		case nil:
+			next := map[string]any{}
+			m[part] = next
+			m = next
+		case map[string]any:
+			m = v
+		default:
+			return fmt.Errorf("key %s conflicts with message %s", key, strings.Join(parts[:i+1], "."))
+		}
+	}
+	last := parts[len(parts)-1]
+	if _, ok := m[last]; ok {
+		return fmt.Errorf("key %s conflicts with another message", key)
+	}
+	m[last] = text
+	return nil
+}
+
+// i18nextEntries uses {{value}} interpolation and a key per plural category,
+// e.g. "items_one" and "items_other".
+func i18nextEntries(id string, m *i18n.Message) (map[string]string, error) {
+	convert := func(src string) (string, error) {
+		return convertTemplate(src, func(s string) string { return s }, func(field string) (string, error) {
+			return "{{" + placeholderName(field) + "}}", nil
+		})
+	}
+	if !isPlural(m) {
+		text, err := convert(m.Other)
+		return map[string]string{id: text}, err
+	}
+	entries := map[string]string{}
+	for _, category := range cldrCategories {
+		if form := pluralForm(m, category); form != "" {
+			text, err := convert(form)
+			if err != nil {
+				return nil, err
+			}
+			entries[id+"_"+category] = text
+		}
+	}
+	return entries, nil
+}
+
+// formatjsEntries uses ICU MessageFormat keyed by message ID. Since formatjs
+// parses <tag> elements, < is quoted as well.
+func formatjsEntries(id string, m *i18n.Message) (map[string]string, error) {
+	var text string
+	var err error
+	if isPlural(m) {
+		text, err = icuPlural(m, map[string]string{}, icuSyntax+"<")
+	} else {
+		text, err = icuMessage(m.Other, map[string]string{}, icuSyntax+"<")
+	}
+	return map[string]string{id: text}, err
+}
+
+// vueEscape writes the characters of vue-i18n message syntax as literal interpolations.
+var vueEscape = strings.NewReplacer("{", "{'{'}", "}", "{'}'}", "@", "{'@'}", "|", "{'|'}", "$", "{'$'}").Replace
+
+// vueEntries uses {value} interpolation. Plural forms are joined with " | " in
+// CLDR order; languages with more than the zero, one and other forms need a
+// pluralRules function in vue-i18n that returns the index of the CLDR category.
+func vueEntries(id string, m *i18n.Message) (map[string]string, error) {
+	convert := func(src string) (string, error) {
+		return convertTemplate(src, vueEscape, func(field string) (string, error) {
+			return "{" + placeholderName(field) + "}", nil
+		})
+	}
+	if !isPlural(m) {
+		text, err := convert(m.Other)
+		return map[string]string{id: text}, err
+	}
+	var forms []string
+	for _, category := range cldrCategories {
+		if form := pluralForm(m, category); form != "" {
+			text, err := convert(form)
+			if err != nil {
+				return nil, err
+			}
+			forms = append(forms, text)
+		}
+	}
+	return map[string]string{id: strings.Join(forms, " | ")}, nil
+}
@@ -0,0 +1,109 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+)
+
+// untranslated is the description of messages copied from the source locale by pvt sync.
+const untranslated = "untranslated"
+
+// localeEntry is a message in the JSON array layout of translator/locales.
+type localeEntry struct {
+	ID          string `json:"id"`
+	Description string `json:"description,omitempty"`
+	Hash        string `json:"hash,omitempty"`
+	LeftDelim   string `json:"leftDelim,omitempty"`
+	RightDelim  string `json:"rightDelim,omitempty"`
+	Zero        string `json:"zero,omitempty"`
+	One         string `json:"one,omitempty"`
+	Two         string `json:"two,omitempty"`
+	Few         string `json:"few,omitempty"`
+	Many        string `json:"many,omitempty"`
+	Other       string `json:"other,omitempty"`
+	Translation string `json:"translation,omitempty"`
+}
+
+// readLocale parses a JSON locale file in any layout go-i18n accepts; "-" reads stdin.
+func (e *env) readLocale(path string) ([]*i18n.Message, error) {
+	var buf []byte
+	var err error
+	if path == "-" {
+		buf, err = io.ReadAll(e.stdin)
+		path = "stdin.json"
+	} else {
+		buf, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return nil, err
+	}
+	file, err := i18n.ParseMessageFileBytes(buf, path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return file.Messages, nil
+}
+
+// marshalLocale encodes msgs sorted by ID in the layout of translator/locales.
+func marshalLocale(msgs []*i18n.Message) ([]byte, error) {
+	sorted := append([]*i18n.Message(nil), msgs...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].ID < sorted[j].ID })
+	entries := make([]localeEntry, 0, len(sorted))
+	for _, m := range sorted {
+		entry := localeEntry{
+			ID:          m.ID,
+			Description: m.Description,
+			Hash:        m.Hash,
+			LeftDelim:   m.LeftDelim,
+			RightDelim:  m.RightDelim,
+			Zero:        m.Zero,
+			One:         m.One,
+			Two:         m.Two,
+			Few:         m.Few,
+			Many:        m.Many,
+			Other:       m.Other,
+		}
+		if m.Zero == "" && m.One == "" && m.Two == "" && m.Few == "" && m.Many == "" {
+			entry.Translation, entry.Other = m.Other, ""
+		}
+		entries = append(entries, entry)
+	}
+	var b bytes.Buffer
+	enc := json.NewEncoder(&b)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(entries); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+// messageText describes the plural forms of m, e.g. "one=1 item; other={{.Count}} items".
+func messageText(m *i18n.Message) string {
+	var forms []string
+	for _, f := range []struct{ name, value string }{
+		{"zero", m.Zero}, {"one", m.One}, {"two", m.Two}, {"few", m.Few}, {"many", m.Many},
+	} {
+		if f.value != "" {
+			forms = append(forms, f.name+"="+f.value)
+		}
+	}
+	if len(forms) == 0 {
+		return m.Other
+	}
+	return strings.Join(append(forms, "other="+m.Other), "; ")
+}
+
+// sameText reports whether a and b have the same delimiters and plural forms.
+func sameText(a, b *i18n.Message) bool {
+	return a.LeftDelim == b.LeftDelim && a.RightDelim == b.RightDelim &&
+		a.Zero == b.Zero && a.One == b.One && a.Two == b.Two &&
+		a.Few == b.Few && a.Many == b.Many && a.Other == b.Other
+}
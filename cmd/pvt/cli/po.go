@@ -0,0 +1,319 @@
+package cli
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+	"golang.org/x/text/language"
+)
+
+// poEntry is a gettext PO entry.
+type poEntry struct {
+	comments []string // extracted comments (#.)
+	flags    []string // #, flags
+	context  string
+	id       string
+	idPlural string
+	plural   bool
+	str      []string // msgstr, or msgstr[n] when plural
+}
+
+// gettextPlural is the gettext Plural-Forms header of a language and the
+// CLDR plural category of each msgstr index.
+type gettextPlural struct {
+	forms      string
+	categories []string
+}
+
+var (
+	pluralOneOther = gettextPlural{"nplurals=2; plural=(n != 1);", []string{"one", "other"}}
+	pluralOther    = gettextPlural{"nplurals=1; plural=0;", []string{"other"}}
+	pluralSlavic   = gettextPlural{"nplurals=3; plural=(n%10==1 && n%100!=11 ? 0 : n%10>=2 && n%10<=4 && (n%100<10 || n%100>=20) ? 1 : 2);", []string{"one", "few", "many"}}
+
+	// gettextPlurals lists languages whose plural rules differ from English.
+	gettextPlurals = map[string]gettextPlural{
+		"zh": pluralOther, "ja": pluralOther, "ko": pluralOther, "vi": pluralOther, "th": pluralOther, "id": pluralOther,
+		"fr": {"nplurals=2; plural=(n > 1);", []string{"one", "other"}},
+		"ru": pluralSlavic, "uk": pluralSlavic,
+		"pl": {"nplurals=3; plural=(n==1 ? 0 : n%10>=2 && n%10<=4 && (n%100<10 || n%100>=20) ? 1 : 2);", []string{"one", "few", "many"}},
+		"ar": {"nplurals=6; plural=(n==0 ? 0 : n==1 ? 1 : n==2 ? 2 : n%100>=3 && n%100<=10 ? 3 : n%100>=11 ? 4 : 5);", []string{"zero", "one", "two", "few", "many", "other"}},
+	}
+)
+
+func pluralFor(tag language.Tag) gettextPlural {
+	base, _ := tag.Base()
+	if p, ok := gettextPlurals[base.String()]; ok {
+		return p
+	}
+	return pluralOneOther
+}
+
+// exportPO returns messages.pot with the source messages and a <lang>.po per translation.
+func exportPO(cat *localeCatalog, docs map[string]string) ([]outputFile, error) {
+	files := []outputFile{{name: "messages.pot", data: encodePO(cat, language.Und, docs)}}
+	for _, tag := range cat.langs {
+		files = append(files, outputFile{name: tag.String() + ".po", data: encodePO(cat, tag, docs)})
+	}
+	return files, nil
+}
+
+// encodePO encodes the translations for tag, or a template when tag is und.
+func encodePO(cat *localeCatalog, tag language.Tag, docs map[string]string) []byte {
+	var b bytes.Buffer
+	plural := gettextPlural{"nplurals=INTEGER; plural=EXPRESSION;", []string{"one", "other"}}
+	lang := ""
+	if tag != language.Und {
+		plural = pluralFor(tag)
+		lang = tag.String()
+	}
+	writePOEntry(&b, poEntry{str: []string{
+		"Project-Id-Version: protovalidate-translator\n" +
+			"Language: " + lang + "\n" +
+			"MIME-Version: 1.0\n" +
+			"Content-Type: text/plain; charset=UTF-8\n" +
+			"Content-Transfer-Encoding: 8bit\n" +
+			"Plural-Forms: " + plural.forms + "\n",
+	}})
+	for _, id := range cat.ids() {
+		src := cat.messages[cat.source][id]
+		entry := poEntry{context: id}
+		if doc := docs[id]; doc != "" {
+			entry.comments = strings.Split(doc, "\n")
+		}
+		var target *i18n.Message
+		if tag != language.Und {
+			if t, ok := cat.messages[tag][id]; ok && t.Description != untranslated {
+				target = t
+			}
+		}
+		if isPlural(src) {
+			entry.plural = true
+			entry.id = src.One
+			if entry.id == "" {
+				entry.id = src.Other
+			}
+			entry.idPlural = src.Other
+			for i, category := range plural.categories {
+				s := ""
+				if target != nil {
+					s = pluralForm(target, category)
+					if s == "" && i == len(plural.categories)-1 {
+						s = target.Other
+					}
+				}
+				entry.str = append(entry.str, s)
+			}
+		} else {
+			entry.id = src.Other
+			s := ""
+			if target != nil {
+				s = target.Other
+			}
+			entry.str = []string{s}
+		}
+		b.WriteByte('\n')
+		writePOEntry(&b, entry)
+	}
+	return b.Bytes()
+}
+
+// importPO decodes a translated PO file into go-i18n messages. Empty and fuzzy
+// translations become untranslated copies of the source text.
+func importPO(e *env, path string, buf []byte) (language.Tag, []*i18n.Message, error) {
+	entries, err := parsePO(buf)
+	if err != nil {
+		return language.Und, nil, fmt.Errorf("%s: %w", path, err)
+	}
+	if len(entries) == 0 || entries[0].id != "" {
+		return language.Und, nil, fmt.Errorf("%s: missing header", path)
+	}
+	tag, err := poLanguage(entries[0])
+	if err != nil {
+		return language.Und, nil, fmt.Errorf("%s: %w", path, err)
+	}
+	plural := pluralFor(tag)
+	var msgs []*i18n.Message
+	for _, entry := range entries[1:] {
+		if entry.context == "" {
+			return language.Und, nil, fmt.Errorf("%s: entry %q has no msgctxt with its rule ID", path, entry.id)
+		}
+		msg := &i18n.Message{ID: entry.context}
+		translated := !slices.Contains(entry.flags, "fuzzy")
+		for _, s := range entry.str {
+			translated = translated && s != ""
+		}
+		switch {
+		case !translated && entry.plural:
+			msg.One, msg.Other, msg.Description = entry.id, entry.idPlural, untranslated
+		case !translated:
+			msg.Other, msg.Description = entry.id, untranslated
+		case entry.plural:
+			if len(entry.str) != len(plural.categories) {
+				return language.Und, nil, fmt.Errorf("%s: %s: want %d plural forms for %s, got %d", path, entry.context, len(plural.categories), tag, len(entry.str))
+			}
+			for i, category := range plural.categories {
+				setPluralForm(msg, category, entry.str[i])
+			}
+			// go-i18n requires other, which gettext folds into its last form.
+			if msg.Other == "" {
+				msg.Other = entry.str[len(entry.str)-1]
+			}
+			warnPlaceholders(e, path, entry.context, entry.idPlural, entry.str...)
+		default:
+			msg.Other = entry.str[0]
+			warnPlaceholders(e, path, entry.context, entry.id, entry.str[0])
+		}
+		msgs = append(msgs, msg)
+	}
+	return tag, msgs, nil
+}
+
+func poLanguage(header poEntry) (language.Tag, error) {
+	for _, line := range strings.Split(strings.Join(header.str, ""), "\n") {
+		if v, ok := strings.CutPrefix(line, "Language:"); ok {
+			if v = strings.TrimSpace(v); v != "" {
+				// gettext uses underscores, e.g. zh_TW.
+				return language.Parse(strings.ReplaceAll(v, "_", "-"))
+			}
+		}
+	}
+	return language.Und, fmt.Errorf("missing Language header")
+}
+
+func writePOEntry(b *bytes.Buffer, entry poEntry) {
+	for _, c := range entry.comments {
+		fmt.Fprintf(b, "#. %s\n", c)
+	}
+	if len(entry.flags) > 0 {
+		fmt.Fprintf(b, "#, %s\n", strings.Join(entry.flags, ", "))
+	}
+	if entry.context != "" {
+		writePOString(b, "msgctxt", entry.context)
+	}
+	writePOString(b, "msgid", entry.id)
+	if entry.plural {
+		writePOString(b, "msgid_plural", entry.idPlural)
+		for i, s := range entry.str {
+			writePOString(b, fmt.Sprintf("msgstr[%d]", i), s)
+		}
+		return
+	}
+	writePOString(b, "msgstr", entry.str[0])
+}
+
+// writePOString writes a keyword and a quoted string, split after each newline.
+func writePOString(b *bytes.Buffer, keyword string, s string) {
+	lines := strings.SplitAfter(s, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	if len(lines) <= 1 {
+		fmt.Fprintf(b, "%s %s\n", keyword, quotePO(s))
+		return
+	}
+	fmt.Fprintf(b, "%s \"\"\n", keyword)
+	for _, line := range lines {
+		fmt.Fprintf(b, "%s\n", quotePO(line))
+	}
+}
+
+func quotePO(s string) string {
+	r := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\t", `\t`, "\r", `\r`)
+	return `"` + r.Replace(s) + `"`
+}
+
+// parsePO parses the entries of a PO file; obsolete (#~) entries are skipped.
+func parsePO(buf []byte) ([]poEntry, error) {
+	var (
+		entries []poEntry
+		cur     poEntry
+		target  *string // string continued by quoted lines
+		started bool
+	)
+	flush := func() {
+		if started {
+			entries = append(entries, cur)
+		}
+		cur, target, started = poEntry{}, nil, false
+	}
+	scanner := bufio.NewScanner(bytes.NewReader(buf))
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "":
+			continue
+		case strings.HasPrefix(line, "#"):
+			if started && cur.str != nil {
+				flush()
+			}
+			switch {
+			case strings.HasPrefix(line, "#."):
+				cur.comments = append(cur.comments, strings.TrimSpace(line[2:]))
+			case strings.HasPrefix(line, "#,"):
+				for _, f := range strings.Split(line[2:], ",") {
+					cur.flags = append(cur.flags, strings.TrimSpace(f))
+				}
+			}
+			continue
+		case strings.HasPrefix(line, `"`):
+			if target == nil {
+				return nil, fmt.Errorf("line %d: unexpected string", n)
+			}
+			s, err := strconv.Unquote(line)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", n, err)
+			}
+			*target += s
+			continue
+		}
+
+		keyword, rest, _ := strings.Cut(line, " ")
+		value, err := strconv.Unquote(strings.TrimSpace(rest))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", n, err)
+		}
+		if (keyword == "msgctxt" || keyword == "msgid") && cur.str != nil {
+			flush()
+		}
+		started = true
+		switch {
+		case keyword == "msgctxt":
+			cur.context = value
+			target = &cur.context
+		case keyword == "msgid":
+			cur.id = value
+			target = &cur.id
+		case keyword == "msgid_plural":
+			cur.idPlural, cur.plural = value, true
+			target = &cur.idPlural
+		case keyword == "msgstr":
+			cur.str = append(cur.str, value)
+			target = &cur.str[len(cur.str)-1]
+		case strings.HasPrefix(keyword, "msgstr["):
+			i, err := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(keyword, "msgstr["), "]"))
+			if err != nil || i != len(cur.str) {
+				return nil, fmt.Errorf("line %d: unexpected %s", n, keyword)
+			}
+			cur.str = append(cur.str, value)
+			target = &cur.str[i]
+		default:
+			return nil, fmt.Errorf("line %d: unknown keyword %q", n, keyword)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	flush()
+	for _, entry := range entries {
+		if entry.str == nil {
+			return nil, fmt.Errorf("entry %q has no msgstr", entry.id)
+		}
+	}
+	return entries, nil
+}
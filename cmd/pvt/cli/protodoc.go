@@ -0,0 +1,61 @@
+package cli
+
+import (
+	"bufio"
+	"os"
+	"regexp"
+	"strings"
+)
+
+var (
+	protoFieldDecl = regexp.MustCompile(`^\s*(optional\s+|repeated\s+)?[\w.]+\s+\w+\s*=\s*\d+`)
+	protoRuleID    = regexp.MustCompile(`\bid:\s*"([^"]+)"`)
+)
+
+// parseRuleDocs maps the rule IDs in a proto file such as buf/validate/validate.proto
+// to the first paragraph of the documentation of the field that declares them.
+func parseRuleDocs(path string) (map[string]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	docs := map[string]string{}
+	var comment []string
+	var fieldDoc string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if text, ok := strings.CutPrefix(line, "//"); ok {
+			comment = append(comment, strings.TrimPrefix(text, " "))
+			continue
+		}
+		if protoFieldDecl.MatchString(line) {
+			fieldDoc = firstParagraph(comment)
+		}
+		if line != "" {
+			comment = nil
+		}
+		for _, m := range protoRuleID.FindAllStringSubmatch(line, -1) {
+			if _, ok := docs[m[1]]; !ok && fieldDoc != "" {
+				docs[m[1]] = fieldDoc
+			}
+		}
+	}
+	return docs, scanner.Err()
+}
+
+func firstParagraph(lines []string) string {
+	var para []string
+	for _, l := range lines {
+		if strings.TrimSpace(l) == "" {
+			if len(para) > 0 {
+				break
+			}
+			continue
+		}
+		para = append(para, l)
+	}
+	return strings.Join(para, "\n")
+}
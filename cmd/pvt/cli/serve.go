@@ -0,0 +1,453 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"os/signal"
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	"buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	"github.com/jzero-io/protovalidate-translator/translator"
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+	"golang.org/x/text/language"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// maxRequestBytes limits the size of request bodies.
+const maxRequestBytes = 1 << 20
+
+// ServerOption configures a Server.
+type ServerOption func(*Server)
+
+// WithLanguages sets the language used when a request names none and its
+// Accept-Language header is empty, and the fallback language. Both default to "en".
+func WithLanguages(lang string, fallback string) ServerOption {
+	return func(s *Server) {
+		s.lang, s.fallback = lang, fallback
+	}
+}
+
+// WithPollInterval sets how often the locale directory is checked for changes.
+// A non-positive interval disables polling; POST /v1/reload still reloads.
+func WithPollInterval(d time.Duration) ServerOption {
+	return func(s *Server) {
+		s.poll = d
+	}
+}
+
+// WithErrorLog sets where failed reloads are reported.
+func WithErrorLog(w io.Writer) ServerOption {
+	return func(s *Server) {
+		s.errorLog = w
+	}
+}
+
+// Server is the HTTP/JSON API of pvt serve:
+//
+//	GET  /v1/languages             languages of the bundle
+//	GET  /v1/locales/{lang}        messages of a language in the locale file layout
+//	POST /v1/translate             {"id", "data", "lang", "fallback"}
+//	POST /v1/translate/batch       {"items": [{"id", "data"}], "lang", "fallback"}
+//	POST /v1/violations?lang=      buf.validate.Violations as protobuf or JSON
+//	POST /v1/reload                reload the locale directory
+type Server struct {
+	dir      string
+	lang     string
+	fallback string
+	poll     time.Duration
+	errorLog io.Writer
+
+	watcher *translator.ReloadingBundle
+	state   atomic.Pointer[serverState]
+	mux     *http.ServeMux
+}
+
+// serverState is a bundle and the messages it was loaded from.
+type serverState struct {
+	bundle   *i18n.Bundle
+	messages map[language.Tag]map[string]*i18n.Message
+}
+
+// NewServer serves the locales in dir, reloading them when they change, or the
+// default bundle when dir is empty. Call Close to stop watching dir.
+func NewServer(dir string, opts ...ServerOption) (*Server, error) {
+	s := &Server{
+		dir:      dir,
+		lang:     translator.DefaultLang,
+		fallback: translator.DefaultLang,
+		poll:     translator.DefaultPollInterval,
+		errorLog: io.Discard,
+		mux:      http.NewServeMux(),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	if dir == "" {
+		bundle, err := translator.DefaultBundle()
+		if err != nil {
+			return nil, err
+		}
+		files, err := translator.LoadMessageFilesFromFS(translator.SelectedLocalesFS, translator.DefaultLocaleDir)
+		if err != nil {
+			return nil, err
+		}
+		s.state.Store(newServerState(bundle, files))
+	} else {
+		watcher, err := translator.WatchBundleDir(dir,
+			translator.WithLoadOptions(translator.WithRecursive()),
+			translator.WithPollInterval(s.poll),
+			translator.OnReload(s.reloaded),
+			translator.OnReloadError(func(err error) { fmt.Fprintln(s.errorLog, "pvt:", err) }),
+		)
+		if err != nil {
+			return nil, err
+		}
+		s.watcher = watcher
+		if err := s.load(); err != nil {
+			watcher.Close()
+			return nil, err
+		}
+		s.mux.HandleFunc("POST /v1/reload", s.handleReload)
+	}
+	s.mux.HandleFunc("GET /v1/languages", s.handleLanguages)
+	s.mux.HandleFunc("GET /v1/locales/{lang}", s.handleLocale)
+	s.mux.HandleFunc("POST /v1/translate", s.handleTranslate)
+	s.mux.HandleFunc("POST /v1/translate/batch", s.handleBatch)
+	s.mux.HandleFunc("POST /v1/violations", s.handleViolations)
+	return s, nil
+}
+
+// reloaded loads the locale directory again after the watcher saw it change.
+// On error the previous state is kept.
+func (s *Server) reloaded(*i18n.Bundle) {
+	if err := s.load(); err != nil {
+		fmt.Fprintf(s.errorLog, "pvt: reload %s: %v\n", s.dir, err)
+	}
+}
+
+// load reads the message files of the locale directory once and serves the bundle
+// built from them, so that translations and locale dumps come from the same files.
+func (s *Server) load() error {
+	files, err := translator.LoadMessageFilesFromDir(s.dir, translator.WithRecursive(), translator.WithTemplateValidation())
+	if err != nil {
+		return err
+	}
+	bundle := translator.NewBundle()
+	for _, file := range files {
+		if err := bundle.AddMessages(file.Tag, file.Messages...); err != nil {
+			return fmt.Errorf("%s: %w", file.Path, err)
+		}
+	}
+	if old := s.state.Swap(newServerState(bundle, files)); old != nil {
+		translator.InvalidateLocalizers(old.bundle)
+	}
+	return nil
+}
+
+func newServerState(bundle *i18n.Bundle, files []*i18n.MessageFile) *serverState {
+	st := &serverState{bundle: bundle, messages: map[language.Tag]map[string]*i18n.Message{}}
+	for _, file := range files {
+		msgs := st.messages[file.Tag]
+		if msgs == nil {
+			msgs = map[string]*i18n.Message{}
+			st.messages[file.Tag] = msgs
+		}
+		for _, m := range file.Messages {
+			msgs[m.ID] = m
+		}
+	}
+	return st
+}
+
+// Close stops watching the locale directory.
+func (s *Server) Close() error {
+	if s.watcher != nil {
+		return s.watcher.Close()
+	}
+	return nil
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+// translateRequest is the body of POST /v1/translate.
+type translateRequest struct {
+	ID       string         `json:"id"`
+	Data     map[string]any `json:"data"`
+	Lang     string         `json:"lang"`
+	Fallback *string        `json:"fallback"`
+}
+
+// batchRequest is the body of POST /v1/translate/batch.
+type batchRequest struct {
+	Items []struct {
+		ID   string         `json:"id"`
+		Data map[string]any `json:"data"`
+	} `json:"items"`
+	Lang     string  `json:"lang"`
+	Fallback *string `json:"fallback"`
+}
+
+// translateResponse is a translated message.
+type translateResponse struct {
+	Message  string `json:"message"`
+	Lang     string `json:"lang,omitempty"`
+	Fallback bool   `json:"fallback,omitempty"`
+	Missing  bool   `json:"missing,omitempty"`
+}
+
+func newTranslateResponse(res translator.Result) translateResponse {
+	return translateResponse{Message: res.Message, Lang: res.Lang, Fallback: res.Fallback, Missing: res.Missing}
+}
+
+// languages returns the requested language and fallback of r.
+func (s *Server) languages(r *http.Request, lang string, fallback *string) (string, string) {
+	if lang == "" {
+		lang = r.URL.Query().Get("lang")
+	}
+	if lang == "" {
+		lang = r.Header.Get("Accept-Language")
+	}
+	if lang == "" {
+		lang = s.lang
+	}
+	if fallback == nil {
+		return lang, s.fallback
+	}
+	return lang, *fallback
+}
+
+func (s *Server) handleLanguages(w http.ResponseWriter, r *http.Request) {
+	// The bundle's default language is und, which has no messages.
+	langs := []string{}
+	for _, tag := range s.state.Load().bundle.LanguageTags() {
+		if tag != language.Und {
+			langs = append(langs, tag.String())
+		}
+	}
+	sort.Strings(langs)
+	writeJSON(w, http.StatusOK, map[string]any{"languages": langs})
+}
+
+func (s *Server) handleLocale(w http.ResponseWriter, r *http.Request) {
+	tag, err := language.Parse(r.PathValue("lang"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	msgs, ok := s.state.Load().messages[tag]
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Errorf("no locale %s", tag))
+		return
+	}
+	list := make([]*i18n.Message, 0, len(msgs))
+	for _, m := range msgs {
+		list = append(list, m)
+	}
+	buf, err := marshalLocale(list)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(buf)
+}
+
+func (s *Server) handleTranslate(w http.ResponseWriter, r *http.Request) {
+	var req translateRequest
+	if !readJSON(w, r, &req) {
+		return
+	}
+	if req.ID == "" {
+		writeError(w, http.StatusBadRequest, errors.New("missing id"))
+		return
+	}
+	lang, fallback := s.languages(r, req.Lang, req.Fallback)
+	res, err := translator.TranslateResult(s.state.Load().bundle, lang, fallback, req.ID, req.Data)
+	if err != nil {
+		writeError(w, http.StatusUnprocessableEntity, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, newTranslateResponse(res))
+}
+
+func (s *Server) handleBatch(w http.ResponseWriter, r *http.Request) {
+	var req batchRequest
+	if !readJSON(w, r, &req) {
+		return
+	}
+	items := make([]translator.BatchItem, len(req.Items))
+	for i, item := range req.Items {
+		if item.ID == "" {
+			writeError(w, http.StatusBadRequest, fmt.Errorf("item %d: missing id", i))
+			return
+		}
+		items[i] = translator.BatchItem{ID: item.ID, Data: item.Data}
+	}
+	lang, fallback := s.languages(r, req.Lang, req.Fallback)
+	results, err := translator.TranslateBatch(s.state.Load().bundle, lang, fallback, items)
+	if err != nil {
+		writeError(w, http.StatusUnprocessableEntity, err)
+		return
+	}
+	out := make([]translateResponse, len(results))
+	for i, res := range results {
+		out[i] = newTranslateResponse(res)
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"results": out})
+}
+
+// handleViolations translates serialized buf.validate.Violations, sent as
+// application/x-protobuf or as protojson. The response is JSON, or the translated
+// Violations when application/x-protobuf is accepted.
+func (s *Server) handleViolations(w http.ResponseWriter, r *http.Request) {
+	buf, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxRequestBytes))
+	if err != nil {
+		writeError(w, http.StatusRequestEntityTooLarge, err)
+		return
+	}
+	var violations validate.Violations
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	switch mediaType {
+	case "application/x-protobuf", "application/protobuf":
+		err = proto.Unmarshal(buf, &violations)
+	default:
+		err = protojson.Unmarshal(buf, &violations)
+	}
+	if err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("decode violations: %w", err))
+		return
+	}
+	lang, fallback := s.languages(r, "", nil)
+	bundle := s.state.Load().bundle
+	if accept, ok := acceptedProtobuf(r.Header.Get("Accept")); ok {
+		translated, err := translator.TranslateViolations(bundle, lang, fallback, &violations)
+		if err == nil {
+			buf, err = proto.Marshal(translated)
+		}
+		if err != nil {
+			writeError(w, http.StatusUnprocessableEntity, err)
+			return
+		}
+		w.Header().Set("Content-Type", accept)
+		w.Write(buf)
+		return
+	}
+	out := make([]violationOutput, 0, len(violations.GetViolations()))
+	for _, v := range violations.GetViolations() {
+		message, err := translator.TranslateViolation(bundle, lang, fallback, v)
+		if err != nil {
+			writeError(w, http.StatusUnprocessableEntity, err)
+			return
+		}
+		field := translator.ProtoFieldPath.FormatFieldPath(lang, v.GetField())
+		out = append(out, violationOutput{Field: field, RuleID: v.GetRuleId(), Message: message})
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"violations": out})
+}
+
+// acceptedProtobuf returns the protobuf media type of an Accept header, unless
+// the header prefers JSON or accepts no protobuf type.
+func acceptedProtobuf(accept string) (string, bool) {
+	var mediaType string
+	var protoQ, jsonQ float64
+	for _, part := range strings.Split(accept, ",") {
+		mt, params, err := mime.ParseMediaType(part)
+		if err != nil {
+			continue
+		}
+		q := 1.0
+		if v, ok := params["q"]; ok {
+			if q, err = strconv.ParseFloat(v, 64); err != nil {
+				continue
+			}
+		}
+		switch mt {
+		case "application/x-protobuf", "application/protobuf":
+			if q > protoQ {
+				mediaType, protoQ = mt, q
+			}
+		case "application/json":
+			jsonQ = max(jsonQ, q)
+		}
+	}
+	return mediaType, protoQ > 0 && protoQ >= jsonQ
+}
+
+func (s *Server) handleReload(w http.ResponseWriter, r *http.Request) {
+	if err := s.watcher.Reload(); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	s.handleLanguages(w, r)
+}
+
+func readJSON(w http.ResponseWriter, r *http.Request, v any) bool {
+	dec := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestBytes))
+	dec.UseNumber()
+	if err := dec.Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("decode request: %w", err))
+		return false
+	}
+	return true
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	enc.Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, map[string]string{"error": err.Error()})
+}
+
+func runServe(e *env, args []string) error {
+	fs := e.flagSet("serve", "[flags]")
+	addr := fs.String("addr", "localhost:8080", "address to listen on")
+	lang := fs.String("lang", translator.DefaultLang, "language of requests without lang and Accept-Language")
+	fallback := fs.String("fallback", translator.DefaultLang, "fallback language; empty for none")
+	locales := fs.String("locales", "", "locale directory to serve and reload on changes instead of the embedded locales")
+	poll := fs.Duration("poll", translator.DefaultPollInterval, "how often to check the locale directory for changes; 0 disables polling")
+	rest, err := parse(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(rest) > 0 {
+		return usageError(fs, "unexpected arguments %v", rest)
+	}
+	s, err := NewServer(*locales, WithLanguages(*lang, *fallback), WithPollInterval(*poll), WithErrorLog(e.stderr))
+	if err != nil {
+		return err
+	}
+	defer s.Close()
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+	srv := &http.Server{Addr: *addr, Handler: s, ReadHeaderTimeout: 10 * time.Second}
+	errc := make(chan error, 1)
+	go func() { errc <- srv.ListenAndServe() }()
+	fmt.Fprintf(e.stderr, "pvt: serving on %s\n", *addr)
+	select {
+	case err := <-errc:
+		return err
+	case <-ctx.Done():
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		return srv.Shutdown(shutdownCtx)
+	}
+}
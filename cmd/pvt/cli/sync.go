@@ -0,0 +1,122 @@
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+)
+
+// errDiff makes pvt exit with status 1 without printing an error,
+// after the differences have been printed.
+var errDiff = errors.New("locales differ")
+
+// syncResult counts the changes made by syncLocale.
+type syncResult struct {
+	added, removed, updated int
+}
+
+func (r syncResult) changed() bool {
+	return r.added+r.removed+r.updated > 0
+}
+
+func runSync(e *env, args []string) error {
+	fs := e.flagSet("sync", "-source <en.json> [flags] [target.json...]")
+	source := fs.String("source", "", "source locale file, e.g. translator/locales/en.json")
+	check := fs.Bool("check", false, "report the changes without writing and exit with status 1 if there are any")
+	targets, err := parse(fs, args)
+	if err != nil {
+		return err
+	}
+	if *source == "" {
+		return usageError(fs, "missing -source")
+	}
+	if len(targets) == 0 {
+		// Every other locale next to the source.
+		matches, err := filepath.Glob(filepath.Join(filepath.Dir(*source), "*.json"))
+		if err != nil {
+			return err
+		}
+		for _, m := range matches {
+			if filepath.Clean(m) != filepath.Clean(*source) {
+				targets = append(targets, m)
+			}
+		}
+	}
+
+	src, err := e.readLocale(*source)
+	if err != nil {
+		return err
+	}
+	pending := false
+	for _, target := range targets {
+		var msgs []*i18n.Message
+		if _, err := os.Stat(target); !errors.Is(err, os.ErrNotExist) {
+			if msgs, err = e.readLocale(target); err != nil {
+				return err
+			}
+		}
+		synced, res := syncLocale(src, msgs)
+		buf, err := marshalLocale(synced)
+		if err != nil {
+			return err
+		}
+		old, _ := os.ReadFile(target)
+		switch {
+		case bytes.Equal(bytes.TrimSuffix(old, []byte("\n")), bytes.TrimSuffix(buf, []byte("\n"))):
+			fmt.Fprintf(e.stdout, "%s: up to date\n", target)
+			continue
+		case res.changed():
+			fmt.Fprintf(e.stdout, "%s: %d added, %d removed, %d updated\n", target, res.added, res.removed, res.updated)
+		default:
+			fmt.Fprintf(e.stdout, "%s: reformatted\n", target)
+		}
+		pending = true
+		if !*check {
+			if err := os.WriteFile(target, buf, 0o644); err != nil {
+				return err
+			}
+		}
+	}
+	if *check && pending {
+		return errDiff
+	}
+	return nil
+}
+
+// syncLocale returns target with the messages of src: missing ones are copied from
+// src and marked untranslated, obsolete ones are removed, and untranslated copies
+// follow changes to src.
+func syncLocale(src, target []*i18n.Message) ([]*i18n.Message, syncResult) {
+	existing := make(map[string]*i18n.Message, len(target))
+	for _, m := range target {
+		existing[m.ID] = m
+	}
+	var res syncResult
+	out := make([]*i18n.Message, 0, len(src))
+	for _, s := range src {
+		t, ok := existing[s.ID]
+		switch {
+		case !ok:
+			t = untranslatedCopy(s)
+			res.added++
+		case t.Description == untranslated && !sameText(t, s):
+			t = untranslatedCopy(s)
+			res.updated++
+		}
+		delete(existing, s.ID)
+		out = append(out, t)
+	}
+	res.removed = len(existing)
+	return out, res
+}
+
+func untranslatedCopy(m *i18n.Message) *i18n.Message {
+	c := *m
+	c.Description = untranslated
+	c.Hash = ""
+	return &c
+}
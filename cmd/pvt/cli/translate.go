@@ -0,0 +1,62 @@
+package cli
+
+import (
+	"fmt"
+	"text/tabwriter"
+
+	"github.com/jzero-io/protovalidate-translator/translator"
+)
+
+func runTranslate(e *env, args []string) error {
+	fs := e.flagSet("translate", "[flags] <rule-id>...")
+	var langs listFlag
+	data := dataFlag{}
+	fs.Var(&langs, "lang", "language to render, comma-separated or repeated (default \"en\")")
+	fallback := fs.String("fallback", translator.DefaultLang, "fallback language; empty for none")
+	fs.Var(data, "data", "template data as key=value or a JSON object; repeatable")
+	locales := fs.String("locales", "", "locale directory to load instead of the embedded locales")
+	ids, err := parse(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(ids) == 0 {
+		return usageError(fs, "missing rule ID")
+	}
+	if len(langs) == 0 {
+		langs = listFlag{translator.DefaultLang}
+	}
+	bundle, err := loadBundle(*locales)
+	if err != nil {
+		return err
+	}
+
+	translate := func(id, lang string) (string, error) {
+		res, err := translator.TranslateResult(bundle, lang, *fallback, id, data)
+		if err != nil {
+			return "", fmt.Errorf("%s (%s): %w", id, lang, err)
+		}
+		if res.Missing {
+			fmt.Fprintf(e.stderr, "pvt: no message for %s in %s\n", id, lang)
+		}
+		return res.Message, nil
+	}
+	if len(ids) == 1 && len(langs) == 1 {
+		msg, err := translate(ids[0], langs[0])
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(e.stdout, msg)
+		return err
+	}
+	w := tabwriter.NewWriter(e.stdout, 0, 4, 2, ' ', 0)
+	for _, id := range ids {
+		for _, lang := range langs {
+			msg, err := translate(id, lang)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\n", id, lang, msg)
+		}
+	}
+	return w.Flush()
+}
@@ -0,0 +1,212 @@
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	"buf.build/go/protovalidate"
+	"github.com/jzero-io/protovalidate-translator/translator"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// errViolations makes pvt exit with status 1 without printing an error,
+// after the violations have been printed.
+var errViolations = errors.New("validation failed")
+
+// violationOutput is a translated violation as printed by pvt validate.
+type violationOutput struct {
+	Field   string `json:"field"`
+	RuleID  string `json:"rule_id"`
+	Message string `json:"message"`
+}
+
+func runValidate(e *env, args []string) error {
+	fs := e.flagSet("validate", "-descriptor-set <file> -message <name> [flags] [message.json]")
+	descriptorSet := fs.String("descriptor-set", "", "binary FileDescriptorSet with the message and its imports (e.g. buf build -o api.binpb)")
+	messageName := fs.String("message", "", "full name of the message, e.g. pkg.User")
+	lang := fs.String("lang", translator.DefaultLang, "language of the messages")
+	fallback := fs.String("fallback", translator.DefaultLang, "fallback language; empty for none")
+	format := fs.String("format", "text", "output format: text, json or table")
+	fieldNames := fs.String("field-names", "proto", "field names in paths: proto (zip_code) or json (zipCode, as in the input)")
+	locales := fs.String("locales", "", "locale directory to load instead of the embedded locales")
+	files, err := parse(fs, args)
+	if err != nil {
+		return err
+	}
+	switch {
+	case *descriptorSet == "":
+		return usageError(fs, "missing -descriptor-set")
+	case *messageName == "":
+		return usageError(fs, "missing -message")
+	case len(files) > 1:
+		return usageError(fs, "at most one message file")
+	case *format != "text" && *format != "json" && *format != "table":
+		return usageError(fs, "unknown format %q", *format)
+	case *fieldNames != "proto" && *fieldNames != "json":
+		return usageError(fs, "unknown field names %q", *fieldNames)
+	}
+
+	desc, types, err := loadMessageDescriptor(*descriptorSet, *messageName)
+	if err != nil {
+		return err
+	}
+	input := e.stdin
+	if len(files) == 1 {
+		f, err := os.Open(files[0])
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		input = f
+	}
+	buf, err := io.ReadAll(input)
+	if err != nil {
+		return err
+	}
+	msg := dynamicpb.NewMessage(desc)
+	if err := (protojson.UnmarshalOptions{Resolver: types}).Unmarshal(buf, msg); err != nil {
+		return fmt.Errorf("decode %s: %w", *messageName, err)
+	}
+
+	validator, err := protovalidate.New(protovalidate.WithMessageDescriptors(desc))
+	if err != nil {
+		return err
+	}
+	var violations []*protovalidate.Violation
+	if err := validator.Validate(msg); err != nil {
+		var valErr *protovalidate.ValidationError
+		if !errors.As(err, &valErr) {
+			return err
+		}
+		violations = valErr.Violations
+	}
+
+	bundle, err := loadBundle(*locales)
+	if err != nil {
+		return err
+	}
+	paths := translator.ProtoFieldPath
+	if *fieldNames == "json" {
+		paths = translator.JSONFieldPath(desc)
+	}
+	out := make([]violationOutput, 0, len(violations))
+	for _, v := range violations {
+		res, err := translator.TranslateResult(bundle, *lang, *fallback, v.Proto.GetRuleId(), map[string]any{"Value": ruleValue(v.RuleValue)})
+		if err != nil {
+			return err
+		}
+		message := res.Message
+		if res.Missing && v.Proto.GetMessage() != "" {
+			// Custom CEL rules carry their own message.
+			message = v.Proto.GetMessage()
+		}
+		field := paths.FormatFieldPath(*lang, v.Proto.GetField())
+		out = append(out, violationOutput{Field: field, RuleID: v.Proto.GetRuleId(), Message: message})
+	}
+	if err := writeViolations(e.stdout, *format, out); err != nil {
+		return err
+	}
+	if len(out) > 0 {
+		return errViolations
+	}
+	return nil
+}
+
+// loadMessageDescriptor reads a FileDescriptorSet and returns the named message and
+// the types of the set, used to resolve google.protobuf.Any and extensions in JSON.
+func loadMessageDescriptor(path string, name string) (protoreflect.MessageDescriptor, *dynamicpb.Types, error) {
+	buf, err := os.ReadFile(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	var set descriptorpb.FileDescriptorSet
+	if err := proto.Unmarshal(buf, &set); err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", path, err)
+	}
+	files, err := protodesc.NewFiles(&set)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", path, err)
+	}
+	d, err := files.FindDescriptorByName(protoreflect.FullName(name))
+	if errors.Is(err, protoregistry.NotFound) {
+		return nil, nil, fmt.Errorf("%s: message %s not found", path, name)
+	} else if err != nil {
+		return nil, nil, err
+	}
+	desc, ok := d.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, nil, fmt.Errorf("%s: %s is not a message", path, name)
+	}
+	return desc, dynamicpb.NewTypes(files), nil
+}
+
+// ruleValue converts a violated rule's value to template data.
+func ruleValue(v protoreflect.Value) any {
+	if !v.IsValid() {
+		return nil
+	}
+	switch x := v.Interface().(type) {
+	case protoreflect.List:
+		values := make([]any, x.Len())
+		for i := range values {
+			values[i] = ruleValue(x.Get(i))
+		}
+		return values
+	case protoreflect.Message:
+		// Well-known types such as Duration and Timestamp render as in JSON.
+		buf, err := protojson.Marshal(x.Interface())
+		if err != nil {
+			return x.Interface()
+		}
+		var s string
+		if json.Unmarshal(buf, &s) == nil {
+			return s
+		}
+		return string(buf)
+	case protoreflect.EnumNumber:
+		return int32(x)
+	case []byte:
+		return string(x)
+	default:
+		return x
+	}
+}
+
+func writeViolations(w io.Writer, format string, violations []violationOutput) error {
+	switch format {
+	case "json":
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		enc.SetEscapeHTML(false)
+		return enc.Encode(violations)
+	case "table":
+		if len(violations) == 0 {
+			return nil
+		}
+		tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(tw, "FIELD\tRULE\tMESSAGE")
+		for _, v := range violations {
+			fmt.Fprintf(tw, "%s\t%s\t%s\n", v.Field, v.RuleID, v.Message)
+		}
+		return tw.Flush()
+	default:
+		for _, v := range violations {
+			if v.Field == "" {
+				fmt.Fprintln(w, v.Message)
+				continue
+			}
+			fmt.Fprintf(w, "%s: %s\n", v.Field, v.Message)
+		}
+		return nil
+	}
+}
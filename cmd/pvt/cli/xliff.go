@@ -0,0 +1,232 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+	"golang.org/x/text/language"
+)
+
+const xliffNamespace = "urn:oasis:names:tc:xliff:document:2.0"
+
+// cldrCategories is the order of plural categories in exported files.
+var cldrCategories = []string{"zero", "one", "two", "few", "many", "other"}
+
+type xliffDoc struct {
+	XMLName xml.Name    `xml:"urn:oasis:names:tc:xliff:document:2.0 xliff"`
+	SrcLang string      `xml:"srcLang,attr"`
+	TrgLang string      `xml:"trgLang,attr"`
+	Files   []xliffFile `xml:"file"`
+}
+
+type xliffFile struct {
+	Units []xliffUnit `xml:"unit"`
+}
+
+type xliffUnit struct {
+	ID       string         `xml:"id,attr"`
+	Segments []xliffSegment `xml:"segment"`
+}
+
+type xliffSegment struct {
+	ID     string     `xml:"id,attr"`
+	State  string     `xml:"state,attr"`
+	Source xliffText  `xml:"source"`
+	Target *xliffText `xml:"target"`
+}
+
+type xliffText struct {
+	Inner string `xml:",innerxml"`
+}
+
+// exportXLIFF returns a <lang>.xlf per translation. Template actions become <ph>
+// elements whose equiv attribute holds the action; plural messages have a segment
+// per plural category of the target language.
+func exportXLIFF(cat *localeCatalog, docs map[string]string) ([]outputFile, error) {
+	var files []outputFile
+	for _, tag := range cat.langs {
+		var b bytes.Buffer
+		b.WriteString(xml.Header)
+		fmt.Fprintf(&b, "<xliff xmlns=%q version=\"2.0\" srcLang=%q trgLang=%q>\n", xliffNamespace, cat.source.String(), tag.String())
+		b.WriteString("  <file id=\"messages\">\n")
+		for _, id := range cat.ids() {
+			src := cat.messages[cat.source][id]
+			target := cat.translation(tag, id)
+			fmt.Fprintf(&b, "    <unit id=\"%s\">\n", escapeXML(id))
+			if doc := docs[id]; doc != "" {
+				fmt.Fprintf(&b, "      <notes>\n        <note category=\"description\">%s</note>\n      </notes>\n", escapeXML(doc))
+			}
+			if !isPlural(src) {
+				writeXLIFFSegment(&b, "", src.Other, target, "other")
+			} else {
+				for _, category := range xliffCategories(tag) {
+					writeXLIFFSegment(&b, category, pluralSource(src, category), target, category)
+				}
+			}
+			b.WriteString("    </unit>\n")
+		}
+		b.WriteString("  </file>\n</xliff>\n")
+		files = append(files, outputFile{name: tag.String() + ".xlf", data: b.Bytes()})
+	}
+	return files, nil
+}
+
+// xliffCategories returns the plural categories of tag in CLDR order, including other.
+func xliffCategories(tag language.Tag) []string {
+	var categories []string
+	for _, c := range cldrCategories {
+		if c == "other" || containsCategory(pluralFor(tag).categories, c) {
+			categories = append(categories, c)
+		}
+	}
+	return categories
+}
+
+func containsCategory(categories []string, c string) bool {
+	for _, v := range categories {
+		if v == c {
+			return true
+		}
+	}
+	return false
+}
+
+// pluralSource returns the source form closest to category.
+func pluralSource(src *i18n.Message, category string) string {
+	if s := pluralForm(src, category); s != "" {
+		return s
+	}
+	return src.Other
+}
+
+func writeXLIFFSegment(b *bytes.Buffer, id string, source string, target *i18n.Message, category string) {
+	attrs := ""
+	if id != "" {
+		attrs = fmt.Sprintf(" id=\"%s\"", id)
+	}
+	state := "initial"
+	text := ""
+	if target != nil {
+		if text = pluralForm(target, category); text != "" {
+			state = "translated"
+		}
+	}
+	fmt.Fprintf(b, "      <segment%s state=\"%s\">\n", attrs, state)
+	ids := map[string]int{}
+	fmt.Fprintf(b, "        <source>%s</source>\n", xliffInline(source, ids))
+	if state == "translated" {
+		fmt.Fprintf(b, "        <target>%s</target>\n", xliffInline(text, ids))
+	}
+	b.WriteString("      </segment>\n")
+}
+
+// xliffInline escapes s and replaces template actions with <ph> elements,
+// numbered by first appearance; ids is shared by the source and target.
+func xliffInline(s string, ids map[string]int) string {
+	var b strings.Builder
+	last := 0
+	for _, loc := range placeholder.FindAllStringIndex(s, -1) {
+		b.WriteString(escapeXML(s[last:loc[0]]))
+		action := s[loc[0]:loc[1]]
+		id, ok := ids[action]
+		if !ok {
+			id = len(ids) + 1
+			ids[action] = id
+		}
+		fmt.Fprintf(&b, "<ph id=\"%d\" disp=\"%s\" equiv=\"%s\"/>", id, escapeXML(action), escapeXML(action))
+		last = loc[1]
+	}
+	b.WriteString(escapeXML(s[last:]))
+	return b.String()
+}
+
+// escapeXML escapes s for use in element content and double-quoted attributes.
+var escapeXML = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", `"`, "&quot;").Replace
+
+// importXLIFF decodes an XLIFF 2.0 file. Segments without a target or in the
+// initial state become untranslated copies of the source.
+func importXLIFF(e *env, path string, buf []byte) (language.Tag, []*i18n.Message, error) {
+	var doc xliffDoc
+	if err := xml.Unmarshal(buf, &doc); err != nil {
+		return language.Und, nil, fmt.Errorf("%s: %w", path, err)
+	}
+	if doc.TrgLang == "" {
+		return language.Und, nil, fmt.Errorf("%s: missing trgLang", path)
+	}
+	tag, err := language.Parse(doc.TrgLang)
+	if err != nil {
+		return language.Und, nil, fmt.Errorf("%s: %w", path, err)
+	}
+	var msgs []*i18n.Message
+	for _, file := range doc.Files {
+		for _, unit := range file.Units {
+			msg := &i18n.Message{ID: unit.ID}
+			translated := true
+			source := &i18n.Message{}
+			for _, seg := range unit.Segments {
+				category := seg.ID
+				if category == "" {
+					category = "other"
+				}
+				src, err := inlineText(seg.Source)
+				if err != nil {
+					return language.Und, nil, fmt.Errorf("%s: %s: %w", path, unit.ID, err)
+				}
+				setPluralForm(source, category, src)
+				if seg.Target == nil || seg.State == "initial" {
+					translated = false
+					continue
+				}
+				text, err := inlineText(*seg.Target)
+				if err != nil {
+					return language.Und, nil, fmt.Errorf("%s: %s: %w", path, unit.ID, err)
+				}
+				setPluralForm(msg, category, text)
+				warnPlaceholders(e, path, unit.ID, src, text)
+			}
+			if !translated {
+				msg = source
+				msg.ID, msg.Description = unit.ID, untranslated
+			}
+			msgs = append(msgs, msg)
+		}
+	}
+	return tag, msgs, nil
+}
+
+// inlineText returns the text of inline content, with <ph> elements replaced by their equiv.
+func inlineText(t xliffText) (string, error) {
+	var b strings.Builder
+	dec := xml.NewDecoder(strings.NewReader(t.Inner))
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			return b.String(), nil
+		}
+		if err != nil {
+			return "", err
+		}
+		switch tok := tok.(type) {
+		case xml.CharData:
+			b.Write(tok)
+		case xml.StartElement:
+			if tok.Name.Local != "ph" {
+				continue
+			}
+			equiv := ""
+			for _, attr := range tok.Attr {
+				if attr.Name.Local == "equiv" || (equiv == "" && attr.Name.Local == "disp") {
+					equiv = attr.Value
+				}
+			}
+			if equiv == "" {
+				return "", fmt.Errorf("<ph> without equiv at offset %d", dec.InputOffset())
+			}
+			b.WriteString(equiv)
+		}
+	}
+}
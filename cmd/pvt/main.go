@@ -0,0 +1,14 @@
+// Command pvt previews and maintains protovalidate-translator locales.
+//
+// Run "pvt help" for the list of commands.
+package main
+
+import (
+	"os"
+
+	"github.com/jzero-io/protovalidate-translator/cmd/pvt/cli"
+)
+
+func main() {
+	os.Exit(cli.Main(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
@@ -0,0 +1,95 @@
+package translator_test
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/jzero-io/protovalidate-translator/translator"
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+	"golang.org/x/text/language"
+)
+
+func TestTranslateBatch_orderAndDedup(t *testing.T) {
+	var ids []string
+	setObserver(t, translator.ObserverFunc(func(_ context.Context, e translator.Event) { ids = append(ids, e.ID) }))
+
+	items := []translator.BatchItem{
+		{ID: "float.lt", Data: map[string]any{"Value": 1}},
+		{ID: "float.finite"},
+		{ID: "float.lt", Data: map[string]any{"Value": 2}},
+		{ID: "float.lt", Data: map[string]any{"Value": 1}},
+		{ID: "nonexistent"},
+		{ID: "float.finite"},
+	}
+	want := []string{"值必须小于 1", "value must be finite", "值必须小于 2", "值必须小于 1", "nonexistent", "value must be finite"}
+	for _, parallelism := range []int{1, 4} {
+		ids = nil
+		results, err := translator.TranslateBatch(newTestBundle(), "zh", "en", items, translator.WithParallelism(parallelism))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(results) != len(items) {
+			t.Fatalf("expected %d results, got %d", len(items), len(results))
+		}
+		for i, res := range results {
+			if res.Message != want[i] {
+				t.Errorf("parallelism %d, item %d: got %q, want %q", parallelism, i, res.Message, want[i])
+			}
+		}
+		if !results[1].Fallback || !results[4].Missing {
+			t.Errorf("expected fallback and missing flags, got %+v and %+v", results[1], results[4])
+		}
+		wantIDs := []string{"float.lt", "float.finite", "float.lt", "float.lt", "nonexistent", "float.finite"}
+		if !slices.Equal(ids, wantIDs) {
+			t.Errorf("parallelism %d: expected an event per item %v, got %v", parallelism, wantIDs, ids)
+		}
+	}
+}
+
+func TestTranslateBatch_error(t *testing.T) {
+	bundle := translator.NewBundle()
+	bundle.MustAddMessages(language.English,
+		&i18n.Message{ID: "ok", Other: "ok"},
+		&i18n.Message{ID: "broken", Other: "{{.Value.Missing}}"},
+	)
+	items := []translator.BatchItem{{ID: "ok"}, {ID: "broken", Data: map[string]any{"Value": 1}}}
+	if _, err := translator.TranslateBatch(bundle, "en", "", items, translator.WithParallelism(2)); err == nil {
+		t.Fatal("expected template error")
+	}
+}
+
+func TestTranslateBatch_empty(t *testing.T) {
+	results, err := translator.TranslateBatch(newTestBundle(), "en", "", nil)
+	if err != nil || len(results) != 0 {
+		t.Errorf("got %v, %v", results, err)
+	}
+}
+
+func BenchmarkTranslateBatch(b *testing.B) {
+	bundle := loadEmbeddedBundle(b)
+	ids := []string{"float.lt", "string.min_len", "string.email", "int32.gt", "repeated.min_items"}
+	items := make([]translator.BatchItem, 10000)
+	for i := range items {
+		items[i] = translator.BatchItem{ID: ids[i%len(ids)], Data: map[string]any{"Value": i % 7}}
+	}
+	b.Run("loop", func(b *testing.B) {
+		for b.Loop() {
+			for _, item := range items {
+				if _, err := translator.Translate(bundle, "zh", "en", item.ID, item.Data); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+	for _, parallelism := range []int{1, 4} {
+		b.Run(fmt.Sprintf("batch/parallelism=%d", parallelism), func(b *testing.B) {
+			for b.Loop() {
+				if _, err := translator.TranslateBatch(bundle, "zh", "en", items, translator.WithParallelism(parallelism)); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,146 @@
+package translator_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jzero-io/protovalidate-translator/translator"
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+	"golang.org/x/text/language"
+)
+
+// translateUncached is the translation path without the localizer cache:
+// a new go-i18n localizer for every language of every call.
+func translateUncached(bundle *i18n.Bundle, lang, defaultLang, id string, data map[string]any) (string, error) {
+	for _, l := range []string{lang, defaultLang} {
+		msg, err := i18n.NewLocalizer(bundle, l).Localize(&i18n.LocalizeConfig{MessageID: id, TemplateData: data})
+		if err == nil {
+			return msg, nil
+		}
+		var notFound *i18n.MessageNotFoundErr
+		if !errors.As(err, &notFound) {
+			return "", err
+		}
+	}
+	return id, nil
+}
+
+func loadEmbeddedBundle(b *testing.B) *i18n.Bundle {
+	b.Helper()
+	bundle, err := translator.LoadBundleFromFS(translator.SelectedLocalesFS, translator.DefaultLocaleDir)
+	if err != nil {
+		b.Fatal(err)
+	}
+	return bundle
+}
+
+func BenchmarkTranslate(b *testing.B) {
+	bundle := loadEmbeddedBundle(b)
+	data := map[string]any{"Value": 100}
+	cases := []struct {
+		name, lang, id string
+	}{
+		{"direct", "zh", "float.lt"},
+		{"acceptLanguage", "zh-TW,zh;q=0.9,en;q=0.8", "float.lt"},
+		{"fallback", "fr-CA", "custom.only_en"},
+	}
+	bundle.MustAddMessages(language.English, &i18n.Message{ID: "custom.only_en", Other: "custom {{.Value}}"})
+	for _, c := range cases {
+		b.Run(c.name+"/uncached", func(b *testing.B) {
+			b.ReportAllocs()
+			for b.Loop() {
+				if _, err := translateUncached(bundle, c.lang, "en", c.id, data); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+		b.Run(c.name+"/cached", func(b *testing.B) {
+			b.ReportAllocs()
+			for b.Loop() {
+				if _, err := translator.Translate(bundle, c.lang, "en", c.id, data); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkTranslate_parallel(b *testing.B) {
+	bundle := loadEmbeddedBundle(b)
+	data := map[string]any{"Value": 100}
+	b.Run("uncached", func(b *testing.B) {
+		b.ReportAllocs()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				if _, err := translateUncached(bundle, "zh", "en", "float.lt", data); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	})
+	b.Run("cached", func(b *testing.B) {
+		b.ReportAllocs()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				if _, err := translator.Translate(bundle, "zh", "en", "float.lt", data); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	})
+}
+
+// BenchmarkTranslate_manyBundles translates with more bundles than the
+// localizer cache held before it evicted the least recently used ones.
+func BenchmarkTranslate_manyBundles(b *testing.B) {
+	bundles := make([]*i18n.Bundle, 64)
+	for i := range bundles {
+		bundles[i] = newTestBundle()
+	}
+	data := map[string]any{"Value": 100}
+	b.Run("uncached", func(b *testing.B) {
+		b.ReportAllocs()
+		i := 0
+		for b.Loop() {
+			if _, err := translateUncached(bundles[i%len(bundles)], "zh", "en", "float.lt", data); err != nil {
+				b.Fatal(err)
+			}
+			i++
+		}
+	})
+	b.Run("cached", func(b *testing.B) {
+		b.ReportAllocs()
+		i := 0
+		for b.Loop() {
+			if _, err := translator.Translate(bundles[i%len(bundles)], "zh", "en", "float.lt", data); err != nil {
+				b.Fatal(err)
+			}
+			i++
+		}
+	})
+}
+
+func BenchmarkTranslate_static(b *testing.B) {
+	bundle := loadEmbeddedBundle(b)
+	for _, lang := range []string{"en", "zh", "zh-TW,zh;q=0.9"} {
+		b.Run(lang, func(b *testing.B) {
+			b.ReportAllocs()
+			for b.Loop() {
+				if _, err := translator.Translate(bundle, lang, "en", "string.email", nil); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkDefaultBundle_build measures cold start; compare with -tags translator_catalog.
+func BenchmarkDefaultBundle_build(b *testing.B) {
+	b.Cleanup(translator.ResetDefaultBundle)
+	b.ReportAllocs()
+	for b.Loop() {
+		if _, err := translator.RebuildDefaultBundle(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
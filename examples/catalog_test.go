@@ -0,0 +1,79 @@
+//go:build translator_catalog
+
+package translator_test
+
+import (
+	"bytes"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/jzero-io/protovalidate-translator/translator"
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+)
+
+func TestCatalog_matchesLocaleFiles(t *testing.T) {
+	translator.ResetDefaultBundle()
+	t.Cleanup(translator.ResetDefaultBundle)
+	compiled, err := translator.DefaultBundle()
+	if err != nil {
+		t.Fatal(err)
+	}
+	loaded, err := translator.LoadBundleFromFS(translator.SelectedLocalesFS, translator.DefaultLocaleDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	buf, err := fs.ReadFile(translator.SelectedLocalesFS, "locales/en.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	file, err := i18n.ParseMessageFileBytes(buf, "en.json", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := []map[string]any{
+		nil,
+		{"Value": 3},
+		{"Value": "abc"},
+		{"Value": []string{"a", "b"}},
+		{"Value": new(int)},
+	}
+	for _, lang := range []string{"en", "zh", "zh-TW"} {
+		for _, msg := range file.Messages {
+			for _, d := range data {
+				want := translator.MustTranslate(loaded, lang, "", msg.ID, d)
+				if got := translator.MustTranslate(compiled, lang, "", msg.ID, d); got != want {
+					t.Fatalf("%s %s %v: got %q, want %q", lang, msg.ID, d, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestCatalog_isUpToDate(t *testing.T) {
+	out := t.TempDir()
+	cmd := exec.Command("go", "run", "./internal/gencatalog", "-dir", "locales", "-out", out)
+	cmd.Dir = filepath.Join("..", "translator")
+	if msg, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("gencatalog: %v\n%s", err, msg)
+	}
+	generated, err := filepath.Glob(filepath.Join(out, "*.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range generated {
+		want, err := os.ReadFile(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := os.ReadFile(filepath.Join("..", "translator", filepath.Base(name)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("%s is out of date; run go generate ./translator", filepath.Base(name))
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package translator_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jzero-io/protovalidate-translator/translator"
+	"github.com/jzero-io/protovalidate-translator/translator/grpclanguage"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestTranslateCtx_languageAndDefaultBundle(t *testing.T) {
+	ctx := translator.WithLanguage(context.Background(), "zh")
+	out, err := translator.TranslateCtx(ctx, "float.lt", map[string]any{"Value": 100})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out != "值必须小于 100" {
+		t.Errorf("got %q", out)
+	}
+}
+
+func TestTranslateCtx_fallbackChain(t *testing.T) {
+	ctx := translator.WithBundle(context.Background(), newTestBundle())
+	ctx = translator.WithLanguage(ctx, "zh")
+
+	out, err := translator.TranslateCtx(ctx, "float.finite", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out != "value must be finite" {
+		t.Errorf("default fallback: got %q", out)
+	}
+
+	ctx = translator.WithLanguage(ctx, "en")
+	ctx = translator.WithFallback(ctx, "zh", "en")
+	if out := translator.MustTranslateCtx(ctx, "string.min_len", map[string]any{"Value": 1}); out != "长度至少为 1" {
+		t.Errorf("fallback to zh: got %q", out)
+	}
+
+	ctx = translator.WithLanguage(ctx, "zh")
+	ctx = translator.WithFallback(ctx)
+	if out := translator.MustTranslateCtx(ctx, "float.finite", nil); out != "float.finite" {
+		t.Errorf("empty chain must not fall back, got %q", out)
+	}
+}
+
+func TestTranslateChain_skipsEmptyAndRepeated(t *testing.T) {
+	out, err := translator.TranslateChain(newTestBundle(), []string{"", "fr", "fr", "zh"}, "string.min_len", map[string]any{"Value": 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out != "长度至少为 2" {
+		t.Errorf("got %q", out)
+	}
+}
+
+func TestLanguageHandler(t *testing.T) {
+	requireLocale(t, "zh-TW")
+	handler := translator.LanguageHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		msg, err := translator.TranslateCtx(r.Context(), "float.lt", map[string]any{"Value": 1})
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		io.WriteString(w, msg)
+	}))
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	for header, want := range map[string]string{
+		"zh-TW,zh;q=0.9": "值必須小於 1",
+		"":               "value must be less than 1",
+	} {
+		req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+		if header != "" {
+			req.Header.Set("Accept-Language", header)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if string(body) != want {
+			t.Errorf("Accept-Language %q: got %q, want %q", header, body, want)
+		}
+	}
+}
+
+// testServerStream is a grpc.ServerStream with only a context.
+type testServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s testServerStream) Context() context.Context { return s.ctx }
+
+func TestGRPCLanguageInterceptors(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("Accept-Language", "zh-TW", "accept-language", "zh;q=0.9"))
+	if lang := grpclanguage.FromIncomingContext(ctx); lang != "zh-TW,zh;q=0.9" {
+		t.Errorf("FromIncomingContext: got %q", lang)
+	}
+	want := translator.MustTranslateDefault("zh-TW", "string.email", nil)
+
+	unary := grpclanguage.UnaryServerInterceptor()
+	out, err := unary(ctx, nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, _ any) (any, error) {
+		return translator.TranslateCtx(ctx, "string.email", nil)
+	})
+	if err != nil || out != want {
+		t.Errorf("unary: got %q, %v, want %q", out, err, want)
+	}
+
+	stream := grpclanguage.StreamServerInterceptor()
+	err = stream(nil, testServerStream{ctx: ctx}, &grpc.StreamServerInfo{}, func(_ any, ss grpc.ServerStream) error {
+		if out := translator.MustTranslateCtx(ss.Context(), "string.email", nil); out != want {
+			t.Errorf("stream: got %q, want %q", out, want)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Without metadata the context is left as it is.
+	_, _ = unary(context.Background(), nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, _ any) (any, error) {
+		if lang := translator.LanguageFromContext(ctx); lang != "" {
+			t.Errorf("got language %q without metadata", lang)
+		}
+		return nil, nil
+	})
+}
@@ -0,0 +1,109 @@
+package translator_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jzero-io/protovalidate-translator/translator"
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+)
+
+func TestAddDefaultMessage_afterFirstUse_rebuilds(t *testing.T) {
+	translator.ResetDefaultBundle()
+	t.Cleanup(translator.ResetDefaultBundle)
+
+	before, err := translator.DefaultBundle()
+	if err != nil {
+		t.Fatal(err)
+	}
+	translator.AddDefaultMessage("en", "custom.late", "late: {{.Value}}")
+	out, err := translator.TranslateDefault("en", "custom.late", map[string]any{"Value": 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out != "late: 1" {
+		t.Errorf("got %q", out)
+	}
+	after, err := translator.DefaultBundle()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if after == before {
+		t.Error("expected a rebuilt bundle")
+	}
+	if out, _ := translator.Translate(before, "en", "", "custom.late", nil); out != "custom.late" {
+		t.Errorf("earlier bundle must not be modified, got %q", out)
+	}
+}
+
+func TestDefaultBundle_customizerErrorIsRetried(t *testing.T) {
+	translator.ResetDefaultBundle()
+	t.Cleanup(translator.ResetDefaultBundle)
+
+	fail := true
+	translator.AddDefaultBundleCustomizer(func(b *i18n.Bundle) error {
+		if fail {
+			return errors.New("not ready")
+		}
+		return nil
+	})
+	if _, err := translator.DefaultBundle(); err == nil {
+		t.Fatal("expected customizer error")
+	}
+	fail = false
+	bundle, err := translator.DefaultBundle()
+	if err != nil {
+		t.Fatalf("expected retry to succeed: %v", err)
+	}
+	if bundle == nil {
+		t.Fatal("DefaultBundle returned nil")
+	}
+}
+
+func TestResetDefaultBundle_dropsCustomizers(t *testing.T) {
+	translator.ResetDefaultBundle()
+	t.Cleanup(translator.ResetDefaultBundle)
+
+	translator.AddDefaultMessage("en", "custom.reset", "custom")
+	if out := translator.MustTranslateDefault("en", "custom.reset", nil); out != "custom" {
+		t.Fatalf("got %q", out)
+	}
+	translator.ResetDefaultBundle()
+	if out := translator.MustTranslateDefault("en", "custom.reset", nil); out != "custom.reset" {
+		t.Errorf("expected customizer to be dropped, got %q", out)
+	}
+}
+
+func TestRebuildDefaultBundle(t *testing.T) {
+	translator.ResetDefaultBundle()
+	t.Cleanup(translator.ResetDefaultBundle)
+
+	first, err := translator.DefaultBundle()
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := translator.RebuildDefaultBundle()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first == second {
+		t.Error("expected a new bundle")
+	}
+	if current, _ := translator.DefaultBundle(); current != second {
+		t.Error("expected rebuilt bundle to be cached")
+	}
+}
+
+func TestAddDefaultPseudoLocales(t *testing.T) {
+	translator.ResetDefaultBundle()
+	t.Cleanup(translator.ResetDefaultBundle)
+
+	translator.AddDefaultPseudoLocales()
+	out, err := translator.TranslateDefault(translator.PseudoAccentLang, "float.finite", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out != translator.PseudoLocalize(translator.PseudoAccentLang, "value must be finite") {
+		t.Errorf("got %q", out)
+	}
+}
@@ -0,0 +1,135 @@
+package translator_test
+
+import (
+	"testing"
+
+	"buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	"github.com/jzero-io/protovalidate-translator/translator"
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+	"golang.org/x/text/language"
+	"google.golang.org/protobuf/proto"
+)
+
+// newLabelBundle returns the embedded locales with labels for a few fields.
+func newLabelBundle(t *testing.T) *i18n.Bundle {
+	t.Helper()
+	bundle, err := translator.LoadBundleFromFS(translator.SelectedLocalesFS, translator.DefaultLocaleDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bundle.MustAddMessages(language.English,
+		&i18n.Message{ID: "label.items", Other: "Items"},
+		&i18n.Message{ID: "label.address", Other: "Address"},
+		&i18n.Message{ID: "label.zip_code", Other: "ZIP code"},
+		&i18n.Message{ID: "label.attributes", Other: "Attributes"},
+	)
+	bundle.MustAddMessages(language.Chinese,
+		&i18n.Message{ID: "label.items", Other: "商品"},
+		&i18n.Message{ID: "label.address", Other: "地址"},
+		&i18n.Message{ID: "label.zip_code", Other: "邮政编码"},
+	)
+	return bundle
+}
+
+// zipCodePath is items[2].address.zip_code.
+func zipCodePath() *validate.FieldPath {
+	items := field("items", 1)
+	items.SetIndex(2)
+	return fieldPath(items, field("address", 2), field("zip_code", 3))
+}
+
+func TestFieldPathRenderer(t *testing.T) {
+	bundle := newLabelBundle(t)
+	color := field("attributes", 4)
+	color.SetStringKey("color")
+	size := field("sizes", 5)
+	size.SetIntKey(-1)
+	shipping := field("shipping_address", 6)
+
+	cases := []struct {
+		name string
+		opts []translator.FieldPathOption
+		lang string
+		path *validate.FieldPath
+		want string
+	}{
+		{"en", []translator.FieldPathOption{translator.WithOneBasedIndex()}, "en", zipCodePath(), "Items › #3 › Address › ZIP code"},
+		{"zeroBased", nil, "en", zipCodePath(), "Items › #2 › Address › ZIP code"},
+		{"zh", []translator.FieldPathOption{translator.WithOneBasedIndex()}, "zh", zipCodePath(), "商品 › 第 3 项 › 地址 › 邮政编码"},
+		{"labelFallback", nil, "zh-TW", zipCodePath(), "Items › 第 2 項 › Address › ZIP code"},
+		{"zhFallback", []translator.FieldPathOption{translator.WithPathFallback("zh")}, "zh-TW", zipCodePath(), "商品 › 第 2 項 › 地址 › 邮政编码"},
+		{"stringKey", nil, "en", fieldPath(color), `Attributes › "color"`},
+		{"stringKeyZhTW", nil, "zh-TW", fieldPath(color), "Attributes › 「color」"},
+		{"intKey", nil, "zh", fieldPath(size), "sizes › “-1”"},
+		{"protoNames", nil, "en", fieldPath(shipping), "shipping_address"},
+		{"jsonNames", []translator.FieldPathOption{translator.WithFieldNames(translator.JSONNames)}, "en", fieldPath(shipping, field("zip_code", 3)), "shippingAddress › ZIP code"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if c.lang == "zh-TW" {
+				requireLocale(t, "zh-TW")
+			}
+			r := translator.NewFieldPathRenderer(bundle, c.opts...)
+			if got := r.FormatFieldPath(c.lang, c.path); got != c.want {
+				t.Errorf("got %q, want %q", got, c.want)
+			}
+		})
+	}
+}
+
+func TestFieldPathRenderer_defaultsWithoutPathMessages(t *testing.T) {
+	bundle := translator.NewBundle()
+	bundle.MustAddMessages(language.English, &i18n.Message{ID: "label.zip_code", Other: "ZIP code"})
+	key := field("attributes", 4)
+	key.SetStringKey("a b")
+	r := translator.NewFieldPathRenderer(bundle)
+	if got, want := r.FormatFieldPath("en", fieldPath(zipCodePath().GetElements()[0], key, field("zip_code", 3))), `items › #2 › attributes › "a b" › ZIP code`; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestFieldPathRenderer_labelsByFullName(t *testing.T) {
+	order := newShopFile(t).Messages().ByName("Order")
+	bundle := translator.NewBundle()
+	bundle.MustAddMessages(language.English,
+		&i18n.Message{ID: "label.items", Other: "Items"},
+		&i18n.Message{ID: "label.zip_code", Other: "ZIP code"},
+		&i18n.Message{ID: "label.shop.Address.zip_code", Other: "Postcode"},
+	)
+	path := fieldPath(field("items", 1), field("shipping_address", 1), field("zip_code", 1))
+	cases := []struct {
+		name string
+		opts []translator.FieldPathOption
+		want string
+	}{
+		{"fullName", []translator.FieldPathOption{translator.WithMessageDescriptor(order)}, "Items › shipping_address › Postcode"},
+		{"withoutDescriptor", nil, "Items › shipping_address › ZIP code"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := translator.NewFieldPathRenderer(bundle, c.opts...).FormatFieldPath("en", path); got != c.want {
+				t.Errorf("got %q, want %q", got, c.want)
+			}
+		})
+	}
+}
+
+func TestTranslateViolations_localizedFieldPaths(t *testing.T) {
+	bundle := newLabelBundle(t)
+	violations := validate.Violations_builder{Violations: []*validate.Violation{
+		validate.Violation_builder{
+			Field:   zipCodePath(),
+			RuleId:  proto.String("string.len"),
+			Message: proto.String("value length must be 5 characters"),
+		}.Build(),
+	}}.Build()
+	r := translator.NewFieldPathRenderer(bundle, translator.WithOneBasedIndex())
+	out, err := translator.TranslateViolations(bundle, "zh", "en", violations, translator.WithFieldPaths(r))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "商品 › 第 3 项 › 地址 › 邮政编码: " + translator.MustTranslate(bundle, "zh", "en", "string.len", map[string]any{"Value": 5})
+	if got := out.GetViolations()[0].GetMessage(); got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
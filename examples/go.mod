@@ -2,23 +2,55 @@ module github.com/jzero-io/protovalidate-translator/examples
 
 go 1.24.3
 
-replace github.com/jzero-io/protovalidate-translator => ../
+replace (
+	github.com/jzero-io/protovalidate-translator => ../
+	github.com/jzero-io/protovalidate-translator/cmd/pvt => ../cmd/pvt
+	github.com/jzero-io/protovalidate-translator/translator/grpclanguage => ../translator/grpclanguage
+	github.com/jzero-io/protovalidate-translator/translator/otelobserver => ../translator/otelobserver
+	github.com/jzero-io/protovalidate-translator/translator/promobserver => ../translator/promobserver
+)
 
 require (
 	buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go v1.36.10-20251209175733-2a1774d88802.1
 	buf.build/go/protovalidate v1.1.0
+	github.com/BurntSushi/toml v1.6.0
 	github.com/jzero-io/protovalidate-translator v0.0.0
+	github.com/jzero-io/protovalidate-translator/cmd/pvt v0.0.0
+	github.com/jzero-io/protovalidate-translator/translator/grpclanguage v0.0.0
+	github.com/jzero-io/protovalidate-translator/translator/otelobserver v0.0.0
+	github.com/jzero-io/protovalidate-translator/translator/promobserver v0.0.0
 	github.com/nicksnyder/go-i18n/v2 v2.6.1
+	github.com/prometheus/client_golang v1.23.2
+	go.opentelemetry.io/otel v1.38.0
+	go.opentelemetry.io/otel/sdk v1.38.0
 	golang.org/x/text v0.32.0
+	google.golang.org/grpc v1.71.0
 	google.golang.org/protobuf v1.36.10
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
 	cel.dev/expr v0.24.0 // indirect
 	github.com/antlr4-go/antlr/v4 v4.13.1 // indirect
+	github.com/beorn7/perks v1.0.1 // indirect
+	github.com/cespare/xxhash/v2 v2.3.0 // indirect
+	github.com/go-logr/logr v1.4.3 // indirect
+	github.com/go-logr/stdr v1.2.2 // indirect
 	github.com/google/cel-go v0.26.1 // indirect
+	github.com/google/uuid v1.6.0 // indirect
+	github.com/kylelemons/godebug v1.1.0 // indirect
+	github.com/munnerz/goautoneg v0.0.0-20191010083416-a7dc8b61c822 // indirect
+	github.com/prometheus/client_model v0.6.2 // indirect
+	github.com/prometheus/common v0.66.1 // indirect
+	github.com/prometheus/procfs v0.16.1 // indirect
 	github.com/stoewer/go-strcase v1.3.1 // indirect
+	go.opentelemetry.io/auto/sdk v1.1.0 // indirect
+	go.opentelemetry.io/otel/metric v1.38.0 // indirect
+	go.opentelemetry.io/otel/trace v1.38.0 // indirect
+	go.yaml.in/yaml/v2 v2.4.2 // indirect
 	golang.org/x/exp v0.0.0-20250813145105-42675adae3e6 // indirect
+	golang.org/x/net v0.43.0 // indirect
+	golang.org/x/sys v0.35.0 // indirect
 	google.golang.org/genproto/googleapis/api v0.0.0-20250811230008-5f3141c8851a // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20250811230008-5f3141c8851a // indirect
 )
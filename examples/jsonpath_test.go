@@ -0,0 +1,158 @@
+package translator_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	"buf.build/go/protovalidate"
+	"github.com/jzero-io/protovalidate-translator/translator"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// newShopFile returns a file with nested, repeated and map fields and a json_name:
+//
+//	message Address { string zip_code = 1 [(buf.validate.field).string.len = 5]; }
+//	message Item { Address shipping_address = 1; }
+//	message Order {
+//	  repeated Item items = 1 [json_name = "lineItems"];
+//	  map<string, Address> addresses = 2;
+//	}
+func newShopFile(t *testing.T) protoreflect.FileDescriptor {
+	t.Helper()
+	zipRules := &descriptorpb.FieldOptions{}
+	proto.SetExtension(zipRules, validate.E_Field, validate.FieldRules_builder{
+		String: validate.StringRules_builder{Len: proto.Uint64(5)}.Build(),
+	}.Build())
+	label := func(l descriptorpb.FieldDescriptorProto_Label) *descriptorpb.FieldDescriptorProto_Label { return &l }
+	typ := func(t descriptorpb.FieldDescriptorProto_Type) *descriptorpb.FieldDescriptorProto_Type { return &t }
+	optional, repeated := label(descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL), label(descriptorpb.FieldDescriptorProto_LABEL_REPEATED)
+	message, str := typ(descriptorpb.FieldDescriptorProto_TYPE_MESSAGE), typ(descriptorpb.FieldDescriptorProto_TYPE_STRING)
+	file := &descriptorpb.FileDescriptorProto{
+		Name:       proto.String("shop/order.proto"),
+		Package:    proto.String("shop"),
+		Syntax:     proto.String("proto3"),
+		Dependency: []string{"buf/validate/validate.proto"},
+		MessageType: []*descriptorpb.DescriptorProto{
+			{Name: proto.String("Address"), Field: []*descriptorpb.FieldDescriptorProto{
+				{Name: proto.String("zip_code"), JsonName: proto.String("zipCode"), Number: proto.Int32(1), Label: optional, Type: str, Options: zipRules},
+			}},
+			{Name: proto.String("Item"), Field: []*descriptorpb.FieldDescriptorProto{
+				{Name: proto.String("shipping_address"), JsonName: proto.String("shippingAddress"), Number: proto.Int32(1), Label: optional, Type: message, TypeName: proto.String(".shop.Address")},
+			}},
+			{
+				Name: proto.String("Order"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					{Name: proto.String("items"), JsonName: proto.String("lineItems"), Number: proto.Int32(1), Label: repeated, Type: message, TypeName: proto.String(".shop.Item")},
+					{Name: proto.String("addresses"), JsonName: proto.String("addresses"), Number: proto.Int32(2), Label: repeated, Type: message, TypeName: proto.String(".shop.Order.AddressesEntry")},
+				},
+				NestedType: []*descriptorpb.DescriptorProto{{
+					Name: proto.String("AddressesEntry"),
+					Field: []*descriptorpb.FieldDescriptorProto{
+						{Name: proto.String("key"), JsonName: proto.String("key"), Number: proto.Int32(1), Label: optional, Type: str},
+						{Name: proto.String("value"), JsonName: proto.String("value"), Number: proto.Int32(2), Label: optional, Type: message, TypeName: proto.String(".shop.Address")},
+					},
+					Options: &descriptorpb.MessageOptions{MapEntry: proto.Bool(true)},
+				}},
+			},
+		},
+	}
+	fd, err := protodesc.NewFile(file, protoregistry.GlobalFiles)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return fd
+}
+
+const shopOrder = `{
+  "lineItems": [{}, {}, {"shippingAddress": {"zipCode": "123"}}],
+  "addresses": {"home": {"zipCode": "1"}}
+}`
+
+func shopViolations(t *testing.T, desc protoreflect.MessageDescriptor) *validate.Violations {
+	t.Helper()
+	msg := dynamicpb.NewMessage(desc)
+	if err := protojson.Unmarshal([]byte(shopOrder), msg); err != nil {
+		t.Fatal(err)
+	}
+	validator, err := protovalidate.New(protovalidate.WithMessageDescriptors(desc))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var valErr *protovalidate.ValidationError
+	if !errors.As(validator.Validate(msg), &valErr) {
+		t.Fatal("expected a validation error")
+	}
+	return valErr.ToProto()
+}
+
+func TestJSONFieldPath(t *testing.T) {
+	desc := newShopFile(t).Messages().ByName("Order")
+	violations := shopViolations(t, desc)
+
+	bundle, err := translator.DefaultBundle()
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := translator.TranslateViolations(bundle, "zh", "en", violations, translator.WithFieldPaths(translator.JSONFieldPath(desc)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	message := translator.MustTranslate(bundle, "zh", "en", "string.len", map[string]any{"Value": 5})
+	want := []string{
+		"lineItems[2].shippingAddress.zipCode: " + message,
+		`addresses["home"].zipCode: ` + message,
+	}
+	for i, v := range out.GetViolations() {
+		if v.GetMessage() != want[i] {
+			t.Errorf("violation %d: got %q, want %q", i, v.GetMessage(), want[i])
+		}
+	}
+
+	// Paths that leave the descriptor keep their names.
+	unknown := fieldPath(field("line_items", 9), field("zip_code", 1))
+	if got := translator.JSONFieldPath(desc).FormatFieldPath("en", unknown); got != "line_items.zip_code" {
+		t.Errorf("unknown field: got %q", got)
+	}
+}
+
+func TestFieldPathRenderer_messageDescriptor(t *testing.T) {
+	desc := newShopFile(t).Messages().ByName("Order")
+	path := shopViolations(t, desc).GetViolations()[0].GetField()
+	bundle := newLabelBundle(t)
+
+	derived := translator.NewFieldPathRenderer(bundle, translator.WithFieldNames(translator.JSONNames))
+	if got, want := derived.FormatFieldPath("en", path), "Items › #2 › shippingAddress › ZIP code"; got != want {
+		t.Errorf("derived names: got %q, want %q", got, want)
+	}
+	resolved := translator.NewFieldPathRenderer(translator.NewBundle(),
+		translator.WithFieldNames(translator.JSONNames), translator.WithMessageDescriptor(desc))
+	if got, want := resolved.FormatFieldPath("en", path), "lineItems › #2 › shippingAddress › zipCode"; got != want {
+		t.Errorf("resolved names: got %q, want %q", got, want)
+	}
+}
+
+func TestPVT_validateJSONFieldNames(t *testing.T) {
+	descriptors := writeDescriptorSet(t, newShopFile(t))
+	args := []string{"validate", "-descriptor-set", descriptors, "-message", "shop.Order"}
+	code, out, errOut := runPVT(t, strings.NewReader(shopOrder), append(args, "-field-names", "json")...)
+	want := "lineItems[2].shippingAddress.zipCode: value length must be 5 characters\n" +
+		`addresses["home"].zipCode: value length must be 5 characters` + "\n"
+	if code != 1 || out != want {
+		t.Errorf("json: exit %d, got %q, want %q (stderr %q)", code, out, want, errOut)
+	}
+	code, out, _ = runPVT(t, strings.NewReader(shopOrder), args...)
+	if code != 1 || !strings.HasPrefix(out, "items[2].shipping_address.zip_code: ") {
+		t.Errorf("proto: exit %d, got %q", code, out)
+	}
+	if code, _, errOut := runPVT(t, strings.NewReader(shopOrder), append(args, "-field-names", "camel")...); code != 2 || !strings.Contains(errOut, "unknown field names") {
+		t.Errorf("bad -field-names: exit %d, %q", code, errOut)
+	}
+}
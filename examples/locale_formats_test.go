@@ -0,0 +1,82 @@
+package translator_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/BurntSushi/toml"
+	"github.com/jzero-io/protovalidate-translator/translator"
+	"gopkg.in/yaml.v3"
+)
+
+func TestLoadBundleFromDir_mixedFormats(t *testing.T) {
+	dir := t.TempDir()
+	writeLocale(t, dir, "en.json", `{"float.lt": "value must be less than {{.Value}}"}`)
+	writeLocale(t, dir, "zh.yaml", "# 浮点数\nfloat.lt: \"值必须小于 {{.Value}}\"\n")
+	writeLocale(t, dir, "zh-TW.yml", "- id: float.lt\n  translation: \"值必須小於 {{.Value}}\"\n")
+	writeLocale(t, dir, "ja.toml", "# 浮動小数点\n\"float.lt\" = \"値は {{.Value}} 未満でなければなりません\"\n")
+	writeLocale(t, dir, "README.md", "ignored")
+
+	bundle, err := translator.LoadBundleFromDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]string{
+		"en":    "value must be less than 1",
+		"zh":    "值必须小于 1",
+		"zh-TW": "值必須小於 1",
+		"ja":    "値は 1 未満でなければなりません",
+	}
+	for lang, expected := range want {
+		out, err := translator.Translate(bundle, lang, "", "float.lt", map[string]any{"Value": 1})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if out != expected {
+			t.Errorf("%s: got %q, want %q", lang, out, expected)
+		}
+	}
+}
+
+func TestLoadBundleFromDir_formatRoundTrip(t *testing.T) {
+	messages := map[string]string{
+		"float.lt":       "value must be less than {{.Value}}",
+		"string.email":   "value must be a valid email address",
+		"string.min_len": "value length must be at least {{.Value}} characters",
+	}
+	marshalers := map[string]func(any) ([]byte, error){
+		"json": json.Marshal,
+		"yaml": yaml.Marshal,
+		"yml":  yaml.Marshal,
+		"toml": func(v any) ([]byte, error) {
+			var buf bytes.Buffer
+			err := toml.NewEncoder(&buf).Encode(v)
+			return buf.Bytes(), err
+		},
+	}
+	for format, marshal := range marshalers {
+		t.Run(format, func(t *testing.T) {
+			buf, err := marshal(messages)
+			if err != nil {
+				t.Fatal(err)
+			}
+			dir := t.TempDir()
+			writeLocale(t, dir, "en."+format, string(buf))
+			bundle, err := translator.LoadBundleFromDir(dir)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for id, template := range messages {
+				out, err := translator.Translate(bundle, "en", "", id, map[string]any{"Value": 1})
+				if err != nil {
+					t.Fatal(err)
+				}
+				if want := strings.ReplaceAll(template, "{{.Value}}", "1"); out != want {
+					t.Errorf("%s: got %q, want %q", id, out, want)
+				}
+			}
+		})
+	}
+}
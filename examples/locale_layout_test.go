@@ -0,0 +1,178 @@
+package translator_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"github.com/jzero-io/protovalidate-translator/translator"
+)
+
+func newLayoutDir(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	for _, sub := range []string{"en", "zh", "drafts"} {
+		if err := os.Mkdir(filepath.Join(dir, sub), 0o755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeLocale(t, dir, "zh-TW.json", `{"float.lt": "值必須小於 {{.Value}}"}`)
+	writeLocale(t, filepath.Join(dir, "en"), "validate.json", `{"float.lt": "value must be less than {{.Value}}"}`)
+	writeLocale(t, filepath.Join(dir, "en"), "fields.yaml", `field.email: "Email"`)
+	writeLocale(t, filepath.Join(dir, "zh"), "validate.json", `{"float.lt": "值必须小于 {{.Value}}"}`)
+	writeLocale(t, filepath.Join(dir, "zh"), "fields.json", `{"field.email": "邮箱"}`)
+	writeLocale(t, filepath.Join(dir, "drafts"), "fields.ja.json", `{"field.email": "メール"}`)
+	return dir
+}
+
+func TestLoadBundleFromDir_skipsSubdirectoriesByDefault(t *testing.T) {
+	bundle, err := translator.LoadBundleFromDir(newLayoutDir(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out, _ := translator.Translate(bundle, "zh", "", "field.email", nil); out != "field.email" {
+		t.Errorf("expected subdirectories to be skipped, got %q", out)
+	}
+}
+
+func TestLoadBundleFromDir_recursive(t *testing.T) {
+	bundle, err := translator.LoadBundleFromDir(newLayoutDir(t), translator.WithRecursive())
+	if err != nil {
+		t.Fatal(err)
+	}
+	cases := []struct {
+		lang, id, want string
+	}{
+		{"en", "float.lt", "value must be less than 1"},
+		{"en", "field.email", "Email"},
+		{"zh", "float.lt", "值必须小于 1"},
+		{"zh", "field.email", "邮箱"},
+		{"zh-TW", "float.lt", "值必須小於 1"},
+		{"ja", "field.email", "メール"},
+	}
+	for _, c := range cases {
+		out, err := translator.Translate(bundle, c.lang, "", c.id, map[string]any{"Value": 1})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if out != c.want {
+			t.Errorf("%s %s: got %q, want %q", c.lang, c.id, out, c.want)
+		}
+	}
+}
+
+func TestLoadBundleFromDir_directoryForNamesWithoutPluralRules(t *testing.T) {
+	dir := t.TempDir()
+	for _, sub := range []string{"en", "zh"} {
+		if err := os.Mkdir(filepath.Join(dir, sub), 0o755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// api, app, tag and my parse as language tags; the directory names the language.
+	writeLocale(t, filepath.Join(dir, "en"), "api.json", `{"api.title": "API"}`)
+	writeLocale(t, filepath.Join(dir, "en"), "tag.json", `{"tag.title": "Tags"}`)
+	writeLocale(t, filepath.Join(dir, "zh"), "api.json", `{"api.title": "接口"}`)
+	writeLocale(t, filepath.Join(dir, "zh"), "app.json", `{"app.title": "应用"}`)
+	writeLocale(t, filepath.Join(dir, "zh"), "my.ja.json", `{"my.title": "マイページ"}`)
+
+	files, err := translator.LoadMessageFilesFromDir(dir, translator.WithRecursive())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]string{
+		"en/api.json":   "en",
+		"en/tag.json":   "en",
+		"zh/api.json":   "zh",
+		"zh/app.json":   "zh",
+		"zh/my.ja.json": "ja",
+	}
+	for _, file := range files {
+		name, err := filepath.Rel(dir, file.Path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := file.Tag.String(); got != want[filepath.ToSlash(name)] {
+			t.Errorf("%s: got language %q, want %q", name, got, want[filepath.ToSlash(name)])
+		}
+	}
+
+	bundle, err := translator.LoadBundleFromDir(dir, translator.WithRecursive())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out := translator.MustTranslate(bundle, "zh", "", "app.title", nil); out != "应用" {
+		t.Errorf("got %q", out)
+	}
+}
+
+func TestLoadBundleFromDir_languageNamesInNonLanguageDirectories(t *testing.T) {
+	dir := t.TempDir()
+	for _, sub := range []string{"api", "src"} {
+		if err := os.Mkdir(filepath.Join(dir, sub), 0o755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// api and src parse as language tags without plural rules; the file name wins.
+	writeLocale(t, filepath.Join(dir, "api"), "en.json", `{"api.title": "API"}`)
+	writeLocale(t, filepath.Join(dir, "api"), "zh.json", `{"api.title": "接口"}`)
+	writeLocale(t, filepath.Join(dir, "src"), "zh-TW.json", `{"api.title": "介面"}`)
+
+	bundle, err := translator.LoadBundleFromDir(dir, translator.WithRecursive())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for lang, want := range map[string]string{"en": "API", "zh": "接口", "zh-TW": "介面"} {
+		if out := translator.MustTranslate(bundle, lang, "", "api.title", nil); out != want {
+			t.Errorf("%s: got %q, want %q", lang, out, want)
+		}
+	}
+}
+
+func TestLoadBundleFromDir_includeExclude(t *testing.T) {
+	dir := newLayoutDir(t)
+	bundle, err := translator.LoadBundleFromDir(dir,
+		translator.WithRecursive(),
+		translator.WithInclude("*/*.json"),
+		translator.WithExclude("drafts/*", "fields.*"),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out, _ := translator.Translate(bundle, "zh", "", "float.lt", map[string]any{"Value": 1}); out != "值必须小于 1" {
+		t.Errorf("zh float.lt: got %q", out)
+	}
+	for _, c := range []struct{ lang, id string }{{"zh", "field.email"}, {"ja", "field.email"}} {
+		if out, _ := translator.Translate(bundle, c.lang, "", c.id, nil); out != c.id {
+			t.Errorf("%s %s: expected file to be filtered out, got %q", c.lang, c.id, out)
+		}
+	}
+	if out, _ := translator.Translate(bundle, "zh-TW", "", "float.lt", map[string]any{"Value": 1}); out == "值必須小於 1" {
+		t.Error("expected top-level zh-TW.json to be filtered out")
+	}
+
+	if _, err := translator.LoadBundleFromDir(dir, translator.WithInclude("*.xml")); err == nil {
+		t.Error("expected error when every file is filtered out")
+	}
+	if _, err := translator.LoadBundleFromDir(dir, translator.WithInclude("[")); err == nil {
+		t.Error("expected error for malformed pattern")
+	}
+}
+
+func TestLoadBundleFromFS_recursive(t *testing.T) {
+	fsys := fstest.MapFS{
+		"locales/zh/validate.json":    {Data: []byte(`{"float.lt": "值必须小于 {{.Value}}"}`)},
+		"locales/en/validate.en.json": {Data: []byte(`{"float.lt": "value must be less than {{.Value}}"}`)},
+	}
+	bundle, err := translator.LoadBundleFromFS(fsys, "locales", translator.WithRecursive())
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := translator.Translate(bundle, "zh", "", "float.lt", map[string]any{"Value": 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out != "值必须小于 2" {
+		t.Errorf("got %q", out)
+	}
+}
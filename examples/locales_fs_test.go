@@ -0,0 +1,56 @@
+package translator_test
+
+import (
+	"embed"
+	"io/fs"
+	"slices"
+	"testing"
+	"testing/fstest"
+
+	"github.com/jzero-io/protovalidate-translator/translator"
+)
+
+func embeddedLocaleFiles(t *testing.T) []string {
+	t.Helper()
+	entries, err := fs.ReadDir(translator.SelectedLocalesFS, translator.DefaultLocaleDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	return names
+}
+
+// requireLocale skips the test unless the locale of lang is embedded, which
+// builds with translator.LocaleSelectBuildTag may leave out.
+func requireLocale(t *testing.T, lang string) {
+	t.Helper()
+	if !slices.Contains(embeddedLocaleFiles(t), lang+".json") {
+		t.Skipf("locale %s is not embedded", lang)
+	}
+}
+
+func TestLocalesFS_isValidFS(t *testing.T) {
+	names := embeddedLocaleFiles(t)
+	paths := make([]string, 0, len(names))
+	for _, name := range names {
+		paths = append(paths, translator.DefaultLocaleDir+"/"+name)
+	}
+	if err := fstest.TestFS(translator.SelectedLocalesFS, paths...); err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Contains(names, translator.DefaultLang+".json") {
+		t.Errorf("%s.json must always be embedded, got %v", translator.DefaultLang, names)
+	}
+}
+
+// LocalesFS keeps its embed.FS type in every build.
+var _ embed.FS = translator.LocalesFS
+
+func TestLocalesFS_holdsDefaultLang(t *testing.T) {
+	if _, err := fs.Stat(translator.LocalesFS, translator.DefaultLocaleDir+"/"+translator.DefaultLang+".json"); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,25 @@
+//go:build translator_select_locales && translator_locale_zh && !translator_locale_zh_tw
+
+package translator_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/jzero-io/protovalidate-translator/translator"
+)
+
+func TestSelectLocales_embedsSelectedLanguages(t *testing.T) {
+	if names := embeddedLocaleFiles(t); !slices.Equal(names, []string{"en.json", "zh.json"}) {
+		t.Fatalf("got %v", names)
+	}
+	translator.ResetDefaultBundle()
+	t.Cleanup(translator.ResetDefaultBundle)
+	out, err := translator.TranslateDefault("zh-TW", "string.email", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := translator.MustTranslateDefault("zh", "string.email", nil); out != want {
+		t.Errorf("zh-TW should fall back to zh: got %q, want %q", out, want)
+	}
+}
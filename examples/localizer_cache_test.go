@@ -0,0 +1,44 @@
+package translator_test
+
+import (
+	"testing"
+
+	"github.com/jzero-io/protovalidate-translator/translator"
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+	"golang.org/x/text/language"
+)
+
+func TestTranslate_seesMessagesAddedAfterFirstUse(t *testing.T) {
+	bundle := newTestBundle()
+	if out := translator.MustTranslate(bundle, "zh", "", "custom.late", nil); out != "custom.late" {
+		t.Fatalf("got %q", out)
+	}
+	bundle.MustAddMessages(language.Chinese, &i18n.Message{ID: "custom.late", Other: "后加的"})
+	if out := translator.MustTranslate(bundle, "zh", "", "custom.late", nil); out != "后加的" {
+		t.Errorf("cached localizer must see new messages, got %q", out)
+	}
+	bundle.MustAddMessages(language.Japanese, &i18n.Message{ID: "custom.late", Other: "後で"})
+	if out := translator.MustTranslate(bundle, "ja", "", "custom.late", nil); out != "後で" {
+		t.Errorf("cached localizer must see new languages, got %q", out)
+	}
+}
+
+func TestInvalidateLocalizers(t *testing.T) {
+	bundle := newTestBundle()
+	before := translator.MustTranslate(bundle, "zh", "en", "float.lt", map[string]any{"Value": 1})
+	translator.InvalidateLocalizers(bundle)
+	translator.InvalidateLocalizers(bundle)
+	after := translator.MustTranslate(bundle, "zh", "en", "float.lt", map[string]any{"Value": 1})
+	if before != after {
+		t.Errorf("got %q before and %q after invalidation", before, after)
+	}
+}
+
+func TestTranslate_manyBundles(t *testing.T) {
+	for i := 0; i < 40; i++ {
+		bundle := newTestBundle()
+		if out := translator.MustTranslate(bundle, "zh", "en", "float.finite", nil); out != "value must be finite" {
+			t.Fatalf("bundle %d: got %q", i, out)
+		}
+	}
+}
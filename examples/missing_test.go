@@ -0,0 +1,87 @@
+package translator_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/jzero-io/protovalidate-translator/translator"
+)
+
+func TestTranslateResult(t *testing.T) {
+	bundle := newTestBundle()
+	cases := []struct {
+		name          string
+		lang, def, id string
+		want          translator.Result
+	}{
+		{"requested", "zh", "en", "float.lt", translator.Result{Message: "值必须小于 1", Lang: "zh"}},
+		{"matched", "zh-TW", "en", "float.lt", translator.Result{Message: "值必须小于 1", Lang: "zh"}},
+		{"fallback", "zh", "en", "float.finite", translator.Result{Message: "value must be finite", Lang: "en", Fallback: true, FallbackDepth: 1}},
+		{"missing", "zh", "en", "nonexistent", translator.Result{Message: "nonexistent", Missing: true}},
+		{"emptyLang", "", "en", "float.finite", translator.Result{Message: "value must be finite", Lang: "en"}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			res, err := translator.TranslateResult(bundle, c.lang, c.def, c.id, map[string]any{"Value": 1})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if res != c.want {
+				t.Errorf("got %+v, want %+v", res, c.want)
+			}
+		})
+	}
+}
+
+func TestTranslateResult_nilBundle(t *testing.T) {
+	res, err := translator.TranslateResult(nil, "en", "", "some.id", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.Missing || res.Message != "some.id" {
+		t.Errorf("got %+v", res)
+	}
+}
+
+func TestMissingCollector(t *testing.T) {
+	collector := translator.NewMissingCollector()
+	translator.SetMissingHandler(collector.Record)
+	t.Cleanup(func() { translator.SetMissingHandler(nil) })
+
+	bundle := newTestBundle()
+	for _, id := range []string{"float.finite", "float.finite", "nonexistent"} {
+		if _, err := translator.Translate(bundle, "zh", "en", id, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := translator.Translate(bundle, "zh", "en", "float.lt", map[string]any{"Value": 1}); err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string][]string{
+		"zh": {"float.finite", "nonexistent"},
+		"en": {"nonexistent"},
+	}
+	if got := collector.Missing(); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if n := collector.Count("zh", "float.finite"); n != 2 {
+		t.Errorf("expected count 2, got %d", n)
+	}
+	collector.Reset()
+	if got := collector.Missing(); len(got) != 0 {
+		t.Errorf("expected empty after Reset, got %v", got)
+	}
+}
+
+func TestSetMissingHandler_nilRemovesHandler(t *testing.T) {
+	calls := 0
+	translator.SetMissingHandler(func(id string, lang string) { calls++ })
+	translator.SetMissingHandler(nil)
+	if _, err := translator.Translate(newTestBundle(), "en", "", "nonexistent", nil); err != nil {
+		t.Fatal(err)
+	}
+	if calls != 0 {
+		t.Errorf("expected handler to be removed, got %d calls", calls)
+	}
+}
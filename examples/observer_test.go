@@ -0,0 +1,181 @@
+package translator_test
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/jzero-io/protovalidate-translator/translator"
+	"github.com/jzero-io/protovalidate-translator/translator/otelobserver"
+	"github.com/jzero-io/protovalidate-translator/translator/promobserver"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+type recordingObserver struct {
+	mu     sync.Mutex
+	events []translator.Event
+}
+
+func (r *recordingObserver) ObserveTranslation(_ context.Context, e translator.Event) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, e)
+}
+
+func setObserver(t *testing.T, o translator.Observer) {
+	t.Helper()
+	translator.SetObserver(o)
+	t.Cleanup(func() { translator.SetObserver(nil) })
+}
+
+func TestObserver_events(t *testing.T) {
+	rec := &recordingObserver{}
+	setObserver(t, rec)
+	bundle := newTestBundle()
+
+	translator.MustTranslate(bundle, "zh", "en", "float.lt", map[string]any{"Value": 1})
+	translator.MustTranslate(bundle, "zh", "en", "float.finite", nil)
+	translator.MustTranslate(bundle, "", "en", "nonexistent", nil)
+
+	if len(rec.events) != 3 {
+		t.Fatalf("expected 3 events, got %d", len(rec.events))
+	}
+	want := []translator.Event{
+		{ID: "float.lt", Lang: "zh", ResolvedLang: "zh"},
+		{ID: "float.finite", Lang: "zh", ResolvedLang: "en", FallbackDepth: 1},
+		{ID: "nonexistent", Lang: "en", Missing: true},
+	}
+	for i, e := range rec.events {
+		if e.Start.IsZero() || e.Duration < 0 {
+			t.Errorf("event %d: missing timing: %+v", i, e)
+		}
+		e.Start, e.Duration = want[i].Start, want[i].Duration
+		if e != want[i] {
+			t.Errorf("event %d: got %+v, want %+v", i, e, want[i])
+		}
+	}
+}
+
+func TestObserver_overlayEvents(t *testing.T) {
+	rec := &recordingObserver{}
+	setObserver(t, rec)
+	overlay := newTestOverlay(t)
+
+	res, err := overlay.TranslateResult("zh", "en", "float.lt", map[string]any{"Value": 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Lang != "zh" || res.Missing {
+		t.Errorf("got %+v", res)
+	}
+	if _, err := overlay.Translate("zh", "en", "nonexistent", nil); err != nil {
+		t.Fatal(err)
+	}
+	want := []translator.Event{
+		{ID: "float.lt", Lang: "zh", ResolvedLang: "zh"},
+		{ID: "nonexistent", Lang: "zh", Missing: true},
+	}
+	if len(rec.events) != len(want) {
+		t.Fatalf("expected %d events, got %d", len(want), len(rec.events))
+	}
+	for i, e := range rec.events {
+		e.Start, e.Duration = want[i].Start, want[i].Duration
+		if e != want[i] {
+			t.Errorf("event %d: got %+v, want %+v", i, e, want[i])
+		}
+	}
+}
+
+func TestPromObserver(t *testing.T) {
+	obs := promobserver.New()
+	registry := prometheus.NewPedanticRegistry()
+	registry.MustRegister(obs)
+	setObserver(t, obs)
+	bundle := newTestBundle()
+
+	translator.MustTranslate(bundle, "zh", "en", "float.lt", map[string]any{"Value": 1})
+	translator.MustTranslate(bundle, "zh", "en", "float.lt", map[string]any{"Value": 2})
+	translator.MustTranslate(bundle, "zh", "en", "float.finite", nil)
+	translator.MustTranslate(bundle, "zh-TW,zh;q=0.9", "en", "nonexistent", nil)
+
+	expected := `
+# HELP protovalidate_translator_translations_total Number of translation calls by rule ID, requested and resolved language, and result.
+# TYPE protovalidate_translator_translations_total counter
+protovalidate_translator_translations_total{lang="zh",resolved_lang="",result="missing",rule_id="missing"} 1
+protovalidate_translator_translations_total{lang="zh",resolved_lang="en",result="fallback",rule_id="float.finite"} 1
+protovalidate_translator_translations_total{lang="zh",resolved_lang="zh",result="translated",rule_id="float.lt"} 2
+`
+	if err := testutil.GatherAndCompare(registry, strings.NewReader(expected), "protovalidate_translator_translations_total"); err != nil {
+		t.Error(err)
+	}
+	if n := testutil.CollectAndCount(obs, "protovalidate_translator_translation_duration_seconds"); n != 1 {
+		t.Errorf("expected 1 duration series, got %d", n)
+	}
+}
+
+func TestPromObserver_languages(t *testing.T) {
+	bundle := newTestBundle()
+	obs := promobserver.New(promobserver.WithLanguages(bundle.LanguageTags()...))
+	registry := prometheus.NewPedanticRegistry()
+	registry.MustRegister(obs)
+	setObserver(t, obs)
+
+	for _, lang := range []string{"zh", "zh-CN", "en-GB", "fr", "not a language", "x-unknown-1"} {
+		translator.MustTranslate(bundle, lang, "en", "float.finite", nil)
+	}
+	translator.MustTranslate(bundle, "zh", "en", "client.supplied.id", nil)
+
+	expected := `
+# HELP protovalidate_translator_translations_total Number of translation calls by rule ID, requested and resolved language, and result.
+# TYPE protovalidate_translator_translations_total counter
+protovalidate_translator_translations_total{lang="en",resolved_lang="en",result="translated",rule_id="float.finite"} 1
+protovalidate_translator_translations_total{lang="other",resolved_lang="en",result="fallback",rule_id="float.finite"} 1
+protovalidate_translator_translations_total{lang="other",resolved_lang="en",result="translated",rule_id="float.finite"} 1
+protovalidate_translator_translations_total{lang="und",resolved_lang="en",result="fallback",rule_id="float.finite"} 1
+protovalidate_translator_translations_total{lang="zh",resolved_lang="",result="missing",rule_id="missing"} 1
+protovalidate_translator_translations_total{lang="zh",resolved_lang="en",result="fallback",rule_id="float.finite"} 2
+`
+	if err := testutil.GatherAndCompare(registry, strings.NewReader(expected), "protovalidate_translator_translations_total"); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestOtelObserver(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	setObserver(t, otelobserver.New(otelobserver.WithTracerProvider(provider)))
+
+	ctx, parent := provider.Tracer("test").Start(context.Background(), "request")
+	ctx = translator.WithBundle(ctx, newTestBundle())
+	ctx = translator.WithLanguage(ctx, "zh")
+	translator.MustTranslateCtx(ctx, "float.finite", nil)
+	parent.End()
+
+	spans := exporter.GetSpans()
+	if len(spans) != 2 {
+		t.Fatalf("expected 2 spans, got %d", len(spans))
+	}
+	span := spans[0]
+	if span.Name != otelobserver.SpanName {
+		t.Errorf("span name: got %q", span.Name)
+	}
+	if span.Parent.SpanID() != parent.SpanContext().SpanID() {
+		t.Error("expected translation span to be a child of the request span")
+	}
+	attrs := map[attribute.Key]attribute.Value{}
+	for _, kv := range span.Attributes {
+		attrs[kv.Key] = kv.Value
+	}
+	if attrs[otelobserver.RuleIDKey].AsString() != "float.finite" ||
+		attrs[otelobserver.LangKey].AsString() != "zh" ||
+		attrs[otelobserver.ResolvedLangKey].AsString() != "en" ||
+		attrs[otelobserver.FallbackDepthKey].AsInt64() != 1 ||
+		attrs[otelobserver.MissingKey].AsBool() {
+		t.Errorf("unexpected attributes: %v", span.Attributes)
+	}
+}
@@ -0,0 +1,144 @@
+package translator_test
+
+import (
+	"testing"
+
+	"github.com/jzero-io/protovalidate-translator/translator"
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+	"golang.org/x/text/language"
+)
+
+func newTestOverlay(t *testing.T) *translator.Overlay {
+	t.Helper()
+	defaults, err := translator.LoadBundleFromFS(translator.SelectedLocalesFS, translator.DefaultLocaleDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	company := translator.NewBundle()
+	company.MustAddMessages(language.English, &i18n.Message{ID: "float.lt", Other: "must be below {{.Value}}"})
+	company.MustAddMessages(language.Chinese, &i18n.Message{ID: "string.email", Other: "邮箱格式不正确"})
+	service := translator.NewBundle()
+	service.MustAddMessages(language.English, &i18n.Message{ID: "float.lt", Other: "price must be below {{.Value}}"})
+	return translator.NewOverlay(
+		translator.Layer{Name: "defaults", Bundle: defaults},
+		translator.Layer{Name: "company", Bundle: company},
+		translator.Layer{Name: "service", Bundle: service},
+	)
+}
+
+func TestOverlay_precedence(t *testing.T) {
+	overlay := newTestOverlay(t)
+	cases := []struct {
+		lang, id, want string
+	}{
+		{"en", "float.lt", "price must be below 1"},
+		{"zh", "float.lt", "值必须小于 1"},
+		{"zh", "string.email", "邮箱格式不正确"},
+		{"en", "float.finite", "value must be finite"},
+		{"fr", "float.lt", "price must be below 1"},
+		{"en", "missing.id", "missing.id"},
+	}
+	for _, c := range cases {
+		out, err := overlay.Translate(c.lang, "en", c.id, map[string]any{"Value": 1})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if out != c.want {
+			t.Errorf("%s %s: got %q, want %q", c.lang, c.id, out, c.want)
+		}
+	}
+}
+
+func TestOverlay_matchConfidence(t *testing.T) {
+	requireLocale(t, "zh-TW")
+	overlay := newTestOverlay(t)
+	defaults := overlay.Layers()[0].Bundle
+	// company only overrides zh, which go-i18n would also pick for zh-TW and zh-HK.
+	cases := []struct {
+		lang, layer string
+	}{
+		{"zh", "company"},
+		{"zh-CN", "company"},
+		{"zh-TW", "defaults"},
+		{"zh-HK", "defaults"},
+	}
+	for _, c := range cases {
+		want := "邮箱格式不正确"
+		if c.layer == "defaults" {
+			want = translator.MustTranslate(defaults, c.lang, "", "string.email", nil)
+		}
+		out, err := overlay.Translate(c.lang, "en", "string.email", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if out != want {
+			t.Errorf("%s: got %q, want %q", c.lang, out, want)
+		}
+		if layer, ok := overlay.LayerFor(c.lang, "string.email"); !ok || layer != c.layer {
+			t.Errorf("%s: LayerFor = %q, want %q", c.lang, layer, c.layer)
+		}
+	}
+}
+
+func TestOverlay_defaultsAreNotMutated(t *testing.T) {
+	overlay := newTestOverlay(t)
+	defaults := overlay.Layers()[0].Bundle
+	out, err := translator.Translate(defaults, "en", "", "float.lt", map[string]any{"Value": 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out != "value must be less than 1" {
+		t.Errorf("got %q", out)
+	}
+}
+
+func TestOverlay_layerFor(t *testing.T) {
+	overlay := newTestOverlay(t)
+	cases := []struct {
+		lang, id, want string
+		ok             bool
+	}{
+		{"en", "float.lt", "service", true},
+		{"zh", "string.email", "company", true},
+		{"zh", "float.lt", "defaults", true},
+		{"en", "missing.id", "", false},
+	}
+	for _, c := range cases {
+		layer, ok := overlay.LayerFor(c.lang, c.id)
+		if layer != c.want || ok != c.ok {
+			t.Errorf("%s %s: got (%q, %v), want (%q, %v)", c.lang, c.id, layer, ok, c.want, c.ok)
+		}
+	}
+}
+
+func TestOverlay_pushAndRemove(t *testing.T) {
+	overlay := newTestOverlay(t)
+	if !overlay.Remove("service") {
+		t.Fatal("expected service layer to be removed")
+	}
+	if overlay.Remove("service") {
+		t.Error("expected second remove to report false")
+	}
+	if layer, _ := overlay.LayerFor("en", "float.lt"); layer != "company" {
+		t.Errorf("after remove: got %q", layer)
+	}
+
+	replacement := translator.NewBundle()
+	replacement.MustAddMessages(language.English, &i18n.Message{ID: "float.lt", Other: "replaced {{.Value}}"})
+	overlay.Push("defaults", replacement)
+	if names := layerNames(overlay); len(names) != 2 || names[0] != "defaults" {
+		t.Errorf("replacing a layer must keep its position, got %v", names)
+	}
+	overlay.Push("hotfix", replacement)
+	if out, _ := overlay.Translate("en", "", "float.lt", map[string]any{"Value": 3}); out != "replaced 3" {
+		t.Errorf("got %q", out)
+	}
+}
+
+func layerNames(o *translator.Overlay) []string {
+	var names []string
+	for _, l := range o.Layers() {
+		names = append(names, l.Name)
+	}
+	return names
+}
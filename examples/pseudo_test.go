@@ -0,0 +1,65 @@
+package translator_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/jzero-io/protovalidate-translator/translator"
+)
+
+func TestPseudoLocalize_accentKeepsPlaceholders(t *testing.T) {
+	out := translator.PseudoLocalize(translator.PseudoAccentLang, "value must be less than {{.Value}}")
+	if !strings.HasPrefix(out, "[") || !strings.HasSuffix(out, "]") {
+		t.Errorf("expected brackets, got %q", out)
+	}
+	if !strings.Contains(out, "{{.Value}}") {
+		t.Errorf("placeholder lost: %q", out)
+	}
+	if strings.Contains(out, "value") {
+		t.Errorf("expected accented text, got %q", out)
+	}
+	if len([]rune(out)) <= len("value must be less than {{.Value}}") {
+		t.Errorf("expected expanded text, got %q", out)
+	}
+}
+
+func TestPseudoLocalize_bidiWrapsWords(t *testing.T) {
+	out := translator.PseudoLocalize(translator.PseudoBidiLang, "value {{.Value}}")
+	if out != "\u200f\u202evalue\u202c {{.Value}}" {
+		t.Errorf("got %q", out)
+	}
+}
+
+func TestPseudoLocalize_otherLangUnchanged(t *testing.T) {
+	if out := translator.PseudoLocalize("zh", "value"); out != "value" {
+		t.Errorf("got %q", out)
+	}
+}
+
+func TestLoadBundleFromFS_withPseudoLocales(t *testing.T) {
+	bundle, err := translator.LoadBundleFromFS(translator.SelectedLocalesFS, translator.DefaultLocaleDir, translator.WithPseudoLocales())
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := translator.Translate(bundle, translator.PseudoAccentLang, "", "float.lt", map[string]any{"Value": 100})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out != translator.PseudoLocalize(translator.PseudoAccentLang, "value must be less than 100") {
+		t.Errorf("en-XA: got %q", out)
+	}
+	out, err = translator.Translate(bundle, translator.PseudoBidiLang, "", "float.lt", map[string]any{"Value": 100})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out, "100") || !strings.HasPrefix(out, "\u200f") {
+		t.Errorf("ar-XB: got %q", out)
+	}
+	en, err := translator.Translate(bundle, "en", "", "float.lt", map[string]any{"Value": 100})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if en != "value must be less than 100" {
+		t.Errorf("en must not be pseudo-localized, got %q", en)
+	}
+}
@@ -0,0 +1,214 @@
+package translator_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/jzero-io/protovalidate-translator/translator"
+)
+
+// newExportLocales writes locales with a plural message, a multi-line message and
+// a language (ru) that lacks translations.
+func newExportLocales(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	writeLocale(t, dir, "en.json", `[
+  {"id": "items", "one": "{{.Count}} item", "other": "{{.Count}} items"},
+  {"id": "quote", "translation": "say \"hi\"\nthen {{.Value}}"}
+]`)
+	writeLocale(t, dir, "fr.json", `[
+  {"id": "items", "one": "{{.Count}} article", "other": "{{.Count}} articles"},
+  {"id": "quote", "translation": "dis \"salut\"\npuis {{.Value}}"}
+]`)
+	writeLocale(t, dir, "ru.json", `[
+  {"id": "quote", "description": "untranslated", "translation": "say \"hi\"\nthen {{.Value}}"}
+]`)
+	return dir
+}
+
+func readFile(t *testing.T, path string) string {
+	t.Helper()
+	buf, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(buf)
+}
+
+// readLocale is readFile without the final newline, which locale files may omit.
+func readLocale(t *testing.T, path string) string {
+	t.Helper()
+	return strings.TrimSuffix(readFile(t, path), "\n")
+}
+
+func TestPVT_exportImportPO_embeddedRoundTrip(t *testing.T) {
+	requireLocale(t, "zh-TW")
+	out := t.TempDir()
+	proto := filepath.Join("..", "third_party", "buf", "validate", "validate.proto")
+	if code, _, errOut := runPVT(t, nil, "export", "-format", "po", "-proto", proto, "-out", out); code != 0 {
+		t.Fatalf("export: %s", errOut)
+	}
+	pot := readFile(t, filepath.Join(out, "messages.pot"))
+	wantEntry := `#. ` + "`finite`" + ` requires the field value to be finite. If the field value is
+#. infinite or NaN, an error message is generated.
+msgctxt "float.finite"
+msgid "value must be finite"
+msgstr ""
+`
+	if !strings.Contains(pot, wantEntry) {
+		t.Errorf("messages.pot lacks entry:\n%s", wantEntry)
+	}
+
+	imported := t.TempDir()
+	code, _, errOut := runPVT(t, nil, "import", "-format", "po", "-out", imported,
+		filepath.Join(out, "zh.po"), filepath.Join(out, "zh-TW.po"))
+	if code != 0 || errOut != "" {
+		t.Fatalf("import: exit %d: %s", code, errOut)
+	}
+	for _, name := range []string{"zh.json", "zh-TW.json"} {
+		if readLocale(t, filepath.Join(imported, name)) != readLocale(t, filepath.Join("..", "translator", "locales", name)) {
+			t.Errorf("%s changed in the PO round trip", name)
+		}
+	}
+}
+
+func TestPVT_exportPO_pluralsAndEscapes(t *testing.T) {
+	out := t.TempDir()
+	if code, _, errOut := runPVT(t, nil, "export", "-format", "po", "-locales", newExportLocales(t), "-out", out); code != 0 {
+		t.Fatalf("export: %s", errOut)
+	}
+	want := `msgid ""
+msgstr ""
+"Project-Id-Version: protovalidate-translator\n"
+"Language: ru\n"
+"MIME-Version: 1.0\n"
+"Content-Type: text/plain; charset=UTF-8\n"
+"Content-Transfer-Encoding: 8bit\n"
+"Plural-Forms: nplurals=3; plural=(n%10==1 && n%100!=11 ? 0 : n%10>=2 && n%10<=4 && (n%100<10 || n%100>=20) ? 1 : 2);\n"
+
+msgctxt "items"
+msgid "{{.Count}} item"
+msgid_plural "{{.Count}} items"
+msgstr[0] ""
+msgstr[1] ""
+msgstr[2] ""
+
+msgctxt "quote"
+msgid ""
+"say \"hi\"\n"
+"then {{.Value}}"
+msgstr ""
+`
+	if got := readFile(t, filepath.Join(out, "ru.po")); got != want {
+		t.Errorf("ru.po:\n%s\nwant:\n%s", got, want)
+	}
+
+	imported := t.TempDir()
+	if code, _, errOut := runPVT(t, nil, "import", "-format", "po", "-out", imported, filepath.Join(out, "fr.po"), filepath.Join(out, "ru.po")); code != 0 {
+		t.Fatalf("import: %s", errOut)
+	}
+	wantFR := `[
+  {
+    "id": "items",
+    "one": "{{.Count}} article",
+    "other": "{{.Count}} articles"
+  },
+  {
+    "id": "quote",
+    "translation": "dis \"salut\"\npuis {{.Value}}"
+  }
+]
+`
+	if got := readFile(t, filepath.Join(imported, "fr.json")); got != wantFR {
+		t.Errorf("fr.json:\n%s\nwant:\n%s", got, wantFR)
+	}
+	if got := readFile(t, filepath.Join(imported, "ru.json")); strings.Count(got, `"description": "untranslated"`) != 2 {
+		t.Errorf("ru.json should mark both messages untranslated:\n%s", got)
+	}
+}
+
+func TestPVT_importPO_placeholderWarning(t *testing.T) {
+	dir := t.TempDir()
+	writeLocale(t, dir, "de.po", `msgid ""
+msgstr ""
+"Language: de\n"
+
+#, fuzzy
+msgctxt "float.gt"
+msgid "value must be greater than {{.Value}}"
+msgstr "Wert muss größer sein"
+
+msgctxt "float.lt"
+msgid "value must be less than {{.Value}}"
+msgstr "Wert muss kleiner sein"
+`)
+	code, _, errOut := runPVT(t, nil, "import", "-format", "po", "-out", dir, filepath.Join(dir, "de.po"))
+	if code != 0 {
+		t.Fatalf("exit %d: %s", code, errOut)
+	}
+	if !strings.Contains(errOut, `float.lt: translation has placeholders [], source has ["{{.Value}}"]`) || strings.Contains(errOut, "float.gt") {
+		t.Errorf("stderr: %q", errOut)
+	}
+	got := readFile(t, filepath.Join(dir, "de.json"))
+	if !strings.Contains(got, `"translation": "value must be greater than {{.Value}}"`) {
+		t.Errorf("fuzzy entry should be imported as untranslated:\n%s", got)
+	}
+}
+
+func TestPVT_importPO_slavicPlurals(t *testing.T) {
+	dir := t.TempDir()
+	writeLocale(t, dir, "ru.po", `msgid ""
+msgstr ""
+"Language: ru\n"
+"Plural-Forms: nplurals=3; plural=(n%10==1 && n%100!=11 ? 0 : n%10>=2 && n%10<=4 && (n%100<10 || n%100>=20) ? 1 : 2);\n"
+
+msgctxt "items"
+msgid "{{.Count}} item"
+msgid_plural "{{.Count}} items"
+msgstr[0] "{{.Count}} товар"
+msgstr[1] "{{.Count}} товара"
+msgstr[2] "{{.Count}} товаров"
+`)
+	writeLocale(t, dir, "pl.po", `msgid ""
+msgstr ""
+"Language: pl\n"
+
+msgctxt "items"
+msgid "{{.Count}} item"
+msgid_plural "{{.Count}} items"
+msgstr[0] "{{.Count}} element"
+msgstr[1] "{{.Count}} elementy"
+msgstr[2] "{{.Count}} elementów"
+`)
+	imported := t.TempDir()
+	code, _, errOut := runPVT(t, nil, "import", "-format", "po", "-out", imported,
+		filepath.Join(dir, "ru.po"), filepath.Join(dir, "pl.po"))
+	if code != 0 {
+		t.Fatalf("import: exit %d: %s", code, errOut)
+	}
+	writeLocale(t, imported, "en.json", `[{"id": "items", "one": "{{.Count}} item", "other": "{{.Count}} items"}]`)
+	bundle, err := translator.LoadBundleFromDir(imported)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for lang, want := range map[string]string{"ru": "5 товаров", "pl": "5 elementów"} {
+		out, err := translator.Translate(bundle, lang, "en", "items", map[string]any{"Count": 5})
+		if err != nil {
+			t.Fatalf("%s: %v", lang, err)
+		}
+		if out != want {
+			t.Errorf("%s: got %q, want %q", lang, out, want)
+		}
+	}
+
+	// The imported files export to the same PO entries.
+	out := t.TempDir()
+	if code, _, errOut := runPVT(t, nil, "export", "-format", "po", "-locales", imported, "-out", out); code != 0 {
+		t.Fatalf("export: %s", errOut)
+	}
+	if got := readFile(t, filepath.Join(out, "ru.po")); !strings.Contains(got, `msgstr[2] "{{.Count}} товаров"`) {
+		t.Errorf("ru.po:\n%s", got)
+	}
+}
@@ -0,0 +1,174 @@
+package translator_test
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var updateGolden = flag.Bool("update", false, "rewrite the golden files in testdata")
+
+// newInterchangeLocales writes locales with a plural message, text that needs
+// escaping in XML and ICU, and a language (ru) that lacks translations.
+func newInterchangeLocales(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	writeLocale(t, dir, "en.json", `[
+  {"id": "items", "one": "{{.Count}} item", "other": "{{.Count}} items"},
+  {"id": "string.pattern", "translation": "value does not match regex pattern \"{{.Pattern}}\""},
+  {"id": "syntax", "translation": "it's <{x}> {} & {{.Value}}\nnext line"}
+]`)
+	writeLocale(t, dir, "fr.json", `[
+  {"id": "items", "one": "{{.Count}} article", "other": "{{.Count}} articles"},
+  {"id": "string.pattern", "translation": "la valeur ne correspond pas au motif « {{.Pattern}} »"},
+  {"id": "syntax", "translation": "c'est <{x}> {} & {{.Value}}\nligne suivante"}
+]`)
+	writeLocale(t, dir, "ru.json", `[
+  {"id": "syntax", "description": "untranslated", "translation": "it's <{x}> {} & {{.Value}}\nnext line"}
+]`)
+	return dir
+}
+
+// checkGolden compares the file written to dir with testdata/interchange/name.
+func checkGolden(t *testing.T, dir string, name string) {
+	t.Helper()
+	got := readFile(t, filepath.Join(dir, filepath.Base(name)))
+	golden := filepath.Join("testdata", "interchange", name)
+	if *updateGolden {
+		if err := os.MkdirAll(filepath.Dir(golden), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(golden, []byte(got), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	if want := readFile(t, golden); got != want {
+		t.Errorf("%s:\n%s\nwant:\n%s", name, got, want)
+	}
+}
+
+func TestPVT_exportImport_golden(t *testing.T) {
+	locales := newInterchangeLocales(t)
+	for _, tc := range []struct {
+		format string
+		files  []string
+	}{
+		{"xliff", []string{"fr.xlf", "ru.xlf"}},
+		{"arb", []string{"app_en.arb", "app_fr.arb", "app_ru.arb"}},
+	} {
+		t.Run(tc.format, func(t *testing.T) {
+			out := t.TempDir()
+			if code, _, errOut := runPVT(t, nil, "export", "-format", tc.format, "-locales", locales, "-out", out); code != 0 {
+				t.Fatalf("export: %s", errOut)
+			}
+			var inputs []string
+			for _, name := range tc.files {
+				checkGolden(t, out, name)
+				inputs = append(inputs, filepath.Join("testdata", "interchange", name))
+			}
+
+			imported := t.TempDir()
+			args := append([]string{"import", "-format", tc.format, "-out", imported}, inputs...)
+			if code, _, errOut := runPVT(t, nil, args...); code != 0 || errOut != "" {
+				t.Fatalf("import: exit %d: %s", code, errOut)
+			}
+			checkGolden(t, imported, "fr.json")
+			if tc.format == "xliff" {
+				checkGolden(t, imported, "ru.json")
+			}
+		})
+	}
+}
+
+func TestPVT_exportImport_embeddedRoundTrip(t *testing.T) {
+	requireLocale(t, "zh-TW")
+	for _, tc := range []struct{ format, glob string }{
+		{"xliff", "*.xlf"},
+		{"arb", "*.arb"},
+	} {
+		t.Run(tc.format, func(t *testing.T) {
+			out := t.TempDir()
+			if code, _, errOut := runPVT(t, nil, "export", "-format", tc.format, "-out", out); code != 0 {
+				t.Fatalf("export: %s", errOut)
+			}
+			inputs, err := filepath.Glob(filepath.Join(out, tc.glob))
+			if err != nil {
+				t.Fatal(err)
+			}
+			imported := t.TempDir()
+			args := append([]string{"import", "-format", tc.format, "-out", imported}, inputs...)
+			if code, _, errOut := runPVT(t, nil, args...); code != 0 || errOut != "" {
+				t.Fatalf("import: exit %d: %s", code, errOut)
+			}
+			for _, name := range []string{"zh.json", "zh-TW.json"} {
+				if readLocale(t, filepath.Join(imported, name)) != readLocale(t, filepath.Join("..", "translator", "locales", name)) {
+					t.Errorf("%s changed in the %s round trip", name, tc.format)
+				}
+			}
+		})
+	}
+}
+
+func TestPVT_importARB_withoutRuleID(t *testing.T) {
+	dir := t.TempDir()
+	writeLocale(t, dir, "app_de.arb", `{
+  "@@locale": "de",
+  "floatGt": "Wert muss größer als {value} sein",
+  "@floatGt": {"x-rule-id": "float.gt"},
+  "title": "Titel"
+}`)
+	code, _, errOut := runPVT(t, nil, "import", "-format", "arb", "-out", dir, filepath.Join(dir, "app_de.arb"))
+	if code != 0 {
+		t.Fatalf("exit %d: %s", code, errOut)
+	}
+	if !strings.Contains(errOut, "title: no x-rule-id, skipped") {
+		t.Errorf("stderr: %q", errOut)
+	}
+	if got := readFile(t, filepath.Join(dir, "de.json")); !strings.Contains(got, `"translation": "Wert muss größer als {{.Value}} sein"`) || strings.Contains(got, "Titel") {
+		t.Errorf("de.json:\n%s", got)
+	}
+}
+
+func TestPVT_importARB_malformedPlaceholder(t *testing.T) {
+	dir := t.TempDir()
+	writeLocale(t, dir, "app_de.arb", `{
+  "@@locale": "de",
+  "floatGt": "Wert muss größer als {value} sein",
+  "@floatGt": {"x-rule-id": "float.gt", "placeholders": {"value": "Value"}}
+}`)
+	code, _, errOut := runPVT(t, nil, "import", "-format", "arb", "-out", dir, filepath.Join(dir, "app_de.arb"))
+	if code == 0 || !strings.Contains(errOut, "@floatGt: placeholder value") {
+		t.Errorf("exit %d: %q", code, errOut)
+	}
+}
+
+func TestPVT_exportFrontend_golden(t *testing.T) {
+	custom := t.TempDir()
+	writeLocale(t, custom, "en.json", `[{"id": "order.total", "translation": "total must be at least {{.Min}} | {{.Currency}}"}]`)
+	writeLocale(t, custom, "fr.json", `[{"id": "order.total", "translation": "le total doit être au moins {{.Min}} {{.Currency}} @ {{.Min}}"}]`)
+	locales := newInterchangeLocales(t)
+	for _, format := range []string{"i18next", "formatjs", "vue-i18n"} {
+		t.Run(format, func(t *testing.T) {
+			out := t.TempDir()
+			code, _, errOut := runPVT(t, nil, "export", "-format", format, "-locales", locales, "-overlay", custom, "-out", out)
+			if code != 0 {
+				t.Fatalf("export: %s", errOut)
+			}
+			for _, name := range []string{"en.json", "fr.json", "ru.json"} {
+				checkGolden(t, out, filepath.Join(format, name))
+			}
+		})
+	}
+}
+
+func TestPVT_exportFrontend_unsupportedAction(t *testing.T) {
+	dir := t.TempDir()
+	writeLocale(t, dir, "en.json", `[{"id": "list", "translation": "{{range .Items}}{{.}}{{end}}"}]`)
+	code, _, errOut := runPVT(t, nil, "export", "-format", "i18next", "-locales", dir, "-out", t.TempDir())
+	if code != 1 || !strings.Contains(errOut, "en: list: template action {{range .Items}} has no equivalent") {
+		t.Errorf("exit %d: %q", code, errOut)
+	}
+}
@@ -0,0 +1,251 @@
+package translator_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	"github.com/jzero-io/protovalidate-translator/cmd/pvt/cli"
+	"github.com/jzero-io/protovalidate-translator/translator"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+func newTestServer(t *testing.T, dir string, opts ...cli.ServerOption) *httptest.Server {
+	t.Helper()
+	s, err := cli.NewServer(dir, opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ts := httptest.NewServer(s)
+	t.Cleanup(func() {
+		ts.Close()
+		s.Close()
+	})
+	return ts
+}
+
+// call sends body to path and decodes the JSON response into out.
+func call(t *testing.T, ts *httptest.Server, method string, path string, header http.Header, body []byte, out any) int {
+	t.Helper()
+	req, err := http.NewRequest(method, ts.URL+path, bytes.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	resp, err := ts.Client().Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	buf, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out != nil {
+		if err := json.Unmarshal(buf, out); err != nil {
+			t.Fatalf("%s %s: %v: %s", method, path, err, buf)
+		}
+	}
+	return resp.StatusCode
+}
+
+type translateResult struct {
+	Message  string `json:"message"`
+	Lang     string `json:"lang"`
+	Fallback bool   `json:"fallback"`
+	Missing  bool   `json:"missing"`
+}
+
+func TestServe_translate(t *testing.T) {
+	ts := newTestServer(t, "")
+
+	var res translateResult
+	code := call(t, ts, "POST", "/v1/translate", nil, []byte(`{"id": "string.min_len", "lang": "zh", "data": {"Value": 3}}`), &res)
+	want := translator.MustTranslateDefault("zh", "string.min_len", map[string]any{"Value": 3})
+	if code != http.StatusOK || res.Message != want || res.Lang != "zh" {
+		t.Errorf("%d %+v, want %q", code, res, want)
+	}
+
+	var batch struct{ Results []translateResult }
+	header := http.Header{"Accept-Language": {"zh-TW"}}
+	code = call(t, ts, "POST", "/v1/translate/batch", header, []byte(`{"items": [
+		{"id": "float.lt", "data": {"Value": 1.5}},
+		{"id": "custom.rule"}
+	]}`), &batch)
+	if code != http.StatusOK || len(batch.Results) != 2 {
+		t.Fatalf("%d %+v", code, batch)
+	}
+	if want := translator.MustTranslateDefault("zh-TW", "float.lt", map[string]any{"Value": 1.5}); batch.Results[0].Message != want {
+		t.Errorf("got %q, want %q", batch.Results[0].Message, want)
+	}
+	if r := batch.Results[1]; !r.Missing || r.Message != "custom.rule" {
+		t.Errorf("missing message: %+v", r)
+	}
+
+	var errResp struct{ Error string }
+	if code := call(t, ts, "POST", "/v1/translate", nil, []byte(`{"lang": "zh"}`), &errResp); code != http.StatusBadRequest || errResp.Error != "missing id" {
+		t.Errorf("%d %+v", code, errResp)
+	}
+}
+
+func TestServe_languagesAndLocale(t *testing.T) {
+	requireLocale(t, "zh-TW")
+	ts := newTestServer(t, "")
+
+	var langs struct{ Languages []string }
+	call(t, ts, "GET", "/v1/languages", nil, nil, &langs)
+	for _, lang := range []string{"en", "zh", "zh-TW"} {
+		if !slices.Contains(langs.Languages, lang) {
+			t.Errorf("languages %v lack %s", langs.Languages, lang)
+		}
+	}
+
+	resp, err := ts.Client().Get(ts.URL + "/v1/locales/zh-TW")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if strings.TrimSuffix(string(got), "\n") != readLocale(t, filepath.Join("..", "translator", "locales", "zh-TW.json")) {
+		t.Errorf("locale dump differs from zh-TW.json")
+	}
+	if code := call(t, ts, "GET", "/v1/locales/fr", nil, nil, nil); code != http.StatusNotFound {
+		t.Errorf("unknown locale: status %d", code)
+	}
+}
+
+func TestServe_violations(t *testing.T) {
+	ts := newTestServer(t, "")
+	violations := &validate.Violations{Violations: []*validate.Violation{
+		{
+			Field:   &validate.FieldPath{Elements: []*validate.FieldPathElement{{FieldName: proto.String("name"), FieldNumber: proto.Int32(1)}}},
+			RuleId:  proto.String("string.min_len"),
+			Message: proto.String("value length must be at least 3 characters"),
+		},
+		{RuleId: proto.String("order.total"), Message: proto.String("total must be positive")},
+	}}
+	want := []map[string]string{
+		{"field": "name", "rule_id": "string.min_len", "message": translator.MustTranslateDefault("zh", "string.min_len", map[string]any{"Value": "3"})},
+		{"field": "", "rule_id": "order.total", "message": "total must be positive"},
+	}
+
+	binary, err := proto.Marshal(violations)
+	if err != nil {
+		t.Fatal(err)
+	}
+	jsonBody, err := protojson.Marshal(violations)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tc := range []struct {
+		name        string
+		contentType string
+		body        []byte
+	}{
+		{"protobuf", "application/x-protobuf", binary},
+		{"json", "application/json", jsonBody},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var got struct{ Violations []map[string]string }
+			code := call(t, ts, "POST", "/v1/violations?lang=zh", http.Header{"Content-Type": {tc.contentType}}, tc.body, &got)
+			if code != http.StatusOK || !slices.EqualFunc(got.Violations, want, func(a, b map[string]string) bool {
+				return a["field"] == b["field"] && a["rule_id"] == b["rule_id"] && a["message"] == b["message"]
+			}) {
+				t.Errorf("%d %v, want %v", code, got.Violations, want)
+			}
+		})
+	}
+}
+
+func TestServe_reload(t *testing.T) {
+	dir := t.TempDir()
+	writeLocale(t, dir, "en.json", `[{"id": "float.lt", "translation": "less than {{.Value}}"}]`)
+	ts := newTestServer(t, dir, cli.WithPollInterval(0), cli.WithErrorLog(io.Discard))
+
+	translate := func(lang string) string {
+		var res translateResult
+		call(t, ts, "POST", "/v1/translate", nil, []byte(`{"id": "float.lt", "lang": "`+lang+`", "data": {"Value": 1}}`), &res)
+		return res.Message
+	}
+	if got := translate("fr"); got != "less than 1" {
+		t.Fatalf("got %q", got)
+	}
+
+	writeLocale(t, dir, "fr.json", `[{"id": "float.lt", "translation": "moins de {{.Value}}"}]`)
+	var langs struct{ Languages []string }
+	if code := call(t, ts, "POST", "/v1/reload", nil, nil, &langs); code != http.StatusOK || !slices.Contains(langs.Languages, "fr") {
+		t.Fatalf("reload: %d %v", code, langs)
+	}
+	if got := translate("fr"); got != "moins de 1" {
+		t.Errorf("after reload: got %q", got)
+	}
+	resp, err := ts.Client().Get(ts.URL + "/v1/locales/fr")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dump, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if !strings.Contains(string(dump), `"translation": "moins de {{.Value}}"`) {
+		t.Errorf("locale dump after reload:\n%s", dump)
+	}
+
+	writeLocale(t, dir, "fr.json", `[{"id": "float.lt", "translation": "moins de {{.Value"}]`)
+	var errResp struct{ Error string }
+	if code := call(t, ts, "POST", "/v1/reload", nil, nil, &errResp); code != http.StatusInternalServerError || errResp.Error == "" {
+		t.Errorf("broken reload: %d %+v", code, errResp)
+	}
+	if got := translate("fr"); got != "moins de 1" {
+		t.Errorf("failed reload should keep the previous bundle, got %q", got)
+	}
+}
+
+func TestServe_violationsProtobufResponse(t *testing.T) {
+	ts := newTestServer(t, "")
+	body, err := proto.Marshal(newTestViolations())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for accept, want := range map[string]string{
+		"application/x-protobuf":                         "application/x-protobuf",
+		"application/x-protobuf, application/json;q=0.5": "application/x-protobuf",
+		"application/json, application/protobuf;q=0.5":   "application/json",
+		"application/x-protobuf;q=0":                     "application/json",
+	} {
+		req, err := http.NewRequest("POST", ts.URL+"/v1/violations?lang=zh", bytes.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Set("Content-Type", "application/x-protobuf")
+		req.Header.Set("Accept", accept)
+		resp, err := ts.Client().Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		buf, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if got := resp.Header.Get("Content-Type"); got != want {
+			t.Errorf("Accept %q: got %s, want %s", accept, got, want)
+			continue
+		}
+		if want != "application/x-protobuf" {
+			continue
+		}
+		var out validate.Violations
+		if err := proto.Unmarshal(buf, &out); err != nil {
+			t.Fatalf("%d: %v: %s", resp.StatusCode, err, buf)
+		}
+		if want := translator.MustTranslateDefault("zh", "string.email", nil); out.GetViolations()[1].GetMessage() != want {
+			t.Errorf("got %q, want %q", out.GetViolations()[1].GetMessage(), want)
+		}
+	}
+}
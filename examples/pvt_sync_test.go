@@ -0,0 +1,88 @@
+package translator_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestPVT_sync(t *testing.T) {
+	dir := t.TempDir()
+	writeLocale(t, dir, "en.json", `[
+  {"id": "b.new", "translation": "new {{.Value}}"},
+  {"id": "a.kept", "translation": "kept"},
+  {"id": "c.changed", "translation": "changed source"}
+]`)
+	writeLocale(t, dir, "zh.json", `[
+  {"id": "a.kept", "translation": "保留"},
+  {"id": "c.changed", "description": "untranslated", "translation": "old source"},
+  {"id": "z.obsolete", "translation": "过时"}
+]`)
+	source := filepath.Join(dir, "en.json")
+	target := filepath.Join(dir, "zh.json")
+
+	code, out, errOut := runPVT(t, nil, "sync", "-check", "-source", source)
+	if code != 1 || out != target+": 1 added, 1 removed, 1 updated\n" {
+		t.Fatalf("check: exit %d, got %q (stderr %q)", code, out, errOut)
+	}
+	if code, _, errOut := runPVT(t, nil, "sync", "-source", source); code != 0 {
+		t.Fatalf("sync: exit %d: %s", code, errOut)
+	}
+	got, err := os.ReadFile(target)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `[
+  {
+    "id": "a.kept",
+    "translation": "保留"
+  },
+  {
+    "id": "b.new",
+    "description": "untranslated",
+    "translation": "new {{.Value}}"
+  },
+  {
+    "id": "c.changed",
+    "description": "untranslated",
+    "translation": "changed source"
+  }
+]
+`
+	if string(got) != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+	if code, out, _ := runPVT(t, nil, "sync", "-check", "-source", source, target); code != 0 || !strings.HasSuffix(out, "up to date\n") {
+		t.Errorf("second check: exit %d, got %q", code, out)
+	}
+}
+
+func TestPVT_syncKeepsEmbeddedLocales(t *testing.T) {
+	source := filepath.Join("..", "translator", "locales", "en.json")
+	code, out, errOut := runPVT(t, nil, "sync", "-check", "-source", source)
+	if code != 0 {
+		t.Errorf("locales are out of sync with en.json; run pvt sync:\n%s%s", out, errOut)
+	}
+}
+
+func TestPVT_diff(t *testing.T) {
+	dir := t.TempDir()
+	writeLocale(t, dir, "new.json", `[
+  {"id": "a", "translation": "same"},
+  {"id": "b", "translation": "after"},
+  {"id": "d", "description": "untranslated", "translation": "added"}
+]`)
+	old := strings.NewReader(`{"a": "same", "b": "before", "c": "gone"}`)
+	code, out, errOut := runPVT(t, old, "diff", "-", filepath.Join(dir, "new.json"))
+	want := `~ b: "before" -> "after"
+- c: "gone"
++ d: "added" (untranslated)
+`
+	if code != 1 || out != want {
+		t.Errorf("exit %d, got:\n%s\nwant:\n%s(stderr %q)", code, out, want, errOut)
+	}
+	if code, out, _ := runPVT(t, nil, "diff", filepath.Join(dir, "new.json"), filepath.Join(dir, "new.json")); code != 0 || out != "" {
+		t.Errorf("same file: exit %d, got %q", code, out)
+	}
+}
@@ -0,0 +1,77 @@
+package translator_test
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/jzero-io/protovalidate-translator/cmd/pvt/cli"
+)
+
+// runPVT runs the pvt command and returns its exit code, stdout and stderr.
+func runPVT(t *testing.T, stdin io.Reader, args ...string) (int, string, string) {
+	t.Helper()
+	if stdin == nil {
+		stdin = strings.NewReader("")
+	}
+	var stdout, stderr bytes.Buffer
+	code := cli.Main(args, stdin, &stdout, &stderr)
+	return code, stdout.String(), stderr.String()
+}
+
+func TestPVT_translate(t *testing.T) {
+	cases := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{"single", []string{"translate", "--lang", "zh", "--data", "Value=3", "float.lt"}, "值必须小于 3\n"},
+		{"flagsAfterID", []string{"translate", "float.lt", "-data", `{"Value": 2.5}`}, "value must be less than 2.5\n"},
+		{"fallback", []string{"translate", "-lang", "fr", "string.email"}, "value must be a valid email address\n"},
+		{"table", []string{"translate", "-lang", "en,zh", "-data", "Value=1", "float.lt", "float.gt"},
+			"float.lt  en  value must be less than 1\n" +
+				"float.lt  zh  值必须小于 1\n" +
+				"float.gt  en  value must be greater than 1\n" +
+				"float.gt  zh  值必须大于 1\n"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			code, out, errOut := runPVT(t, nil, c.args...)
+			if code != 0 {
+				t.Fatalf("exit %d: %s", code, errOut)
+			}
+			if out != c.want {
+				t.Errorf("got %q, want %q", out, c.want)
+			}
+		})
+	}
+}
+
+func TestPVT_translateLocalesDir(t *testing.T) {
+	dir := t.TempDir()
+	writeLocale(t, dir, "en.json", `{"custom.rule": "custom {{.Name}}"}`)
+	code, out, errOut := runPVT(t, nil, "translate", "-locales", dir, "-data", "Name=x", "custom.rule")
+	if code != 0 || out != "custom x\n" {
+		t.Fatalf("exit %d, got %q, stderr %q", code, out, errOut)
+	}
+	code, out, errOut = runPVT(t, nil, "translate", "-locales", dir, "float.lt")
+	if code != 0 || out != "float.lt\n" || !strings.Contains(errOut, "no message for float.lt") {
+		t.Errorf("exit %d, got %q, stderr %q", code, out, errOut)
+	}
+}
+
+func TestPVT_usageErrors(t *testing.T) {
+	for _, args := range [][]string{
+		{"translate"},
+		{"translate", "-data", "novalue", "float.lt"},
+		{"nope"},
+	} {
+		if code, _, _ := runPVT(t, nil, args...); code != 2 {
+			t.Errorf("%v: got exit %d, want 2", args, code)
+		}
+	}
+	if code, out, _ := runPVT(t, nil, "help"); code != 0 || !strings.Contains(out, "translate") {
+		t.Errorf("help: exit %d, %q", code, out)
+	}
+}
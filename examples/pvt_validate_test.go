@@ -0,0 +1,103 @@
+package translator_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/jzero-io/protovalidate-translator/examples/translate/testdata/pb"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// writeDescriptorSet writes fd and its imports as a FileDescriptorSet, like buf build -o.
+func writeDescriptorSet(t *testing.T, fd protoreflect.FileDescriptor) string {
+	t.Helper()
+	set := &descriptorpb.FileDescriptorSet{}
+	seen := map[string]bool{}
+	var add func(fd protoreflect.FileDescriptor)
+	add = func(fd protoreflect.FileDescriptor) {
+		if seen[fd.Path()] {
+			return
+		}
+		seen[fd.Path()] = true
+		imports := fd.Imports()
+		for i := 0; i < imports.Len(); i++ {
+			add(imports.Get(i).FileDescriptor)
+		}
+		set.File = append(set.File, protodesc.ToFileDescriptorProto(fd))
+	}
+	add(fd)
+	buf, err := proto.Marshal(set)
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "api.binpb")
+	if err := os.WriteFile(path, buf, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestPVT_validate(t *testing.T) {
+	requireLocale(t, "zh-TW")
+	descriptors := writeDescriptorSet(t, pb.File_translate_testdata_proto_user_proto)
+	invalid := `{"email": "nope", "age": 10, "name": "ab"}`
+	validate := func(input string, args ...string) (int, string, string) {
+		t.Helper()
+		args = append([]string{"validate", "-descriptor-set", descriptors, "-message", "testdata.User"}, args...)
+		return runPVT(t, strings.NewReader(input), args...)
+	}
+
+	code, out, errOut := validate(invalid, "-lang", "zh")
+	want := "email: 值必须是有效的电子邮件地址\nage: 值必须大于 17\n"
+	if code != 1 || out != want {
+		t.Errorf("text: exit %d, got %q, want %q (stderr %q)", code, out, want, errOut)
+	}
+
+	code, out, _ = validate(invalid, "-format", "json")
+	var got []map[string]string
+	if err := json.Unmarshal([]byte(out), &got); err != nil || code != 1 {
+		t.Fatalf("json: exit %d, %v: %s", code, err, out)
+	}
+	if len(got) != 2 || got[1]["field"] != "age" || got[1]["rule_id"] != "int32.gt" || got[1]["message"] != "value must be greater than 17" {
+		t.Errorf("json: got %v", got)
+	}
+
+	code, out, _ = validate(invalid, "-format", "table", "-lang", "zh-TW")
+	if code != 1 || !strings.HasPrefix(out, "FIELD  RULE          MESSAGE\n") || !strings.Contains(out, "age    int32.gt      值必須大於 17") {
+		t.Errorf("table: exit %d, got %q", code, out)
+	}
+
+	if code, out, errOut := validate(`{"email": "a@example.com", "age": 18, "name": "ab"}`); code != 0 || out != "" {
+		t.Errorf("valid: exit %d, got %q (stderr %q)", code, out, errOut)
+	}
+}
+
+func TestPVT_validateErrors(t *testing.T) {
+	descriptors := writeDescriptorSet(t, pb.File_translate_testdata_proto_user_proto)
+	cases := []struct {
+		name  string
+		input string
+		args  []string
+		code  int
+		err   string
+	}{
+		{"unknownMessage", "{}", []string{"-descriptor-set", descriptors, "-message", "testdata.Nope"}, 1, "not found"},
+		{"badJSON", "{", []string{"-descriptor-set", descriptors, "-message", "testdata.User"}, 1, "decode testdata.User"},
+		{"missingMessage", "{}", []string{"-descriptor-set", descriptors}, 2, "missing -message"},
+		{"badFormat", "{}", []string{"-descriptor-set", descriptors, "-message", "testdata.User", "-format", "xml"}, 2, "unknown format"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			code, _, errOut := runPVT(t, strings.NewReader(c.input), append([]string{"validate"}, c.args...)...)
+			if code != c.code || !strings.Contains(errOut, c.err) {
+				t.Errorf("exit %d, stderr %q", code, errOut)
+			}
+		})
+	}
+}
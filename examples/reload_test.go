@@ -0,0 +1,120 @@
+package translator_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jzero-io/protovalidate-translator/translator"
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+)
+
+func writeLocale(t *testing.T, dir string, name string, content string) {
+	t.Helper()
+	if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestWatchBundleDir_reloadsOnChange(t *testing.T) {
+	dir := t.TempDir()
+	writeLocale(t, dir, "en.json", `[{"id": "float.lt", "translation": "less than {{.Value}}"}]`)
+
+	reloaded := make(chan *i18n.Bundle, 1)
+	watcher, err := translator.WatchBundleDir(dir,
+		translator.WithPollInterval(10*time.Millisecond),
+		translator.OnReload(func(b *i18n.Bundle) { reloaded <- b }),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer watcher.Close()
+
+	out, err := watcher.Translate("en", "", "float.lt", map[string]any{"Value": 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out != "less than 1" {
+		t.Errorf("initial: got %q", out)
+	}
+
+	writeLocale(t, dir, "en.json", `[{"id": "float.lt", "translation": "must be less than {{.Value}}"}]`)
+	select {
+	case b := <-reloaded:
+		if b != watcher.Bundle() {
+			t.Error("callback bundle is not the current bundle")
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("bundle was not reloaded")
+	}
+	out, err = watcher.Translate("en", "", "float.lt", map[string]any{"Value": 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out != "must be less than 1" {
+		t.Errorf("reloaded: got %q", out)
+	}
+}
+
+func TestWatchBundleDir_keepsBundleOnError(t *testing.T) {
+	dir := t.TempDir()
+	writeLocale(t, dir, "en.json", `[{"id": "float.lt", "translation": "less than {{.Value}}"}]`)
+
+	failed := make(chan error, 1)
+	watcher, err := translator.WatchBundleDir(dir,
+		translator.WithPollInterval(10*time.Millisecond),
+		translator.OnReloadError(func(err error) { failed <- err }),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer watcher.Close()
+	before := watcher.Bundle()
+
+	writeLocale(t, dir, "en.json", `[{"id": "float.lt", "translation": "less than {{.Value}"}]`)
+	select {
+	case <-failed:
+	case <-time.After(2 * time.Second):
+		t.Fatal("invalid template was not reported")
+	}
+	if watcher.Bundle() != before {
+		t.Error("expected previous bundle to be kept")
+	}
+	out, err := watcher.Translate("en", "", "float.lt", map[string]any{"Value": 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out != "less than 1" {
+		t.Errorf("got %q", out)
+	}
+}
+
+func TestWatchBundleDir_manualReload(t *testing.T) {
+	dir := t.TempDir()
+	writeLocale(t, dir, "en.json", `[{"id": "a", "translation": "A"}]`)
+	watcher, err := translator.WatchBundleDir(dir, translator.WithPollInterval(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer watcher.Close()
+
+	writeLocale(t, dir, "en.json", `not json`)
+	if err := watcher.Reload(); err == nil {
+		t.Fatal("expected reload error")
+	}
+	writeLocale(t, dir, "zh.json", `[{"id": "a", "translation": "甲"}]`)
+	writeLocale(t, dir, "en.json", `[{"id": "a", "translation": "AA"}]`)
+	if err := watcher.Reload(); err != nil {
+		t.Fatal(err)
+	}
+	if out, _ := watcher.Translate("zh", "", "a", nil); out != "甲" {
+		t.Errorf("zh: got %q", out)
+	}
+}
+
+func TestWatchBundleDir_initialLoadFails(t *testing.T) {
+	if _, err := translator.WatchBundleDir(filepath.Join("testdata", "missing")); err == nil {
+		t.Fatal("expected error for missing dir")
+	}
+}
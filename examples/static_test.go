@@ -0,0 +1,94 @@
+package translator_test
+
+import (
+	"testing"
+
+	"github.com/jzero-io/protovalidate-translator/translator"
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+	"golang.org/x/text/language"
+)
+
+func TestTranslate_staticMessageDoesNotAllocate(t *testing.T) {
+	bundle, err := translator.LoadBundleFromFS(translator.SelectedLocalesFS, translator.DefaultLocaleDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	matcher := language.NewMatcher(bundle.LanguageTags())
+	for _, lang := range []string{"en", "zh", "zh-TW"} {
+		// go-i18n matches the language on every lookup, and x/text allocates
+		// when matching some regional tags.
+		tags := []language.Tag{language.MustParse(lang)}
+		matching := testing.AllocsPerRun(100, func() { matcher.Match(tags...) })
+
+		want := translator.MustTranslate(bundle, lang, "en", "string.email", nil)
+		allocs := testing.AllocsPerRun(100, func() {
+			if out := translator.MustTranslate(bundle, lang, "en", "string.email", nil); out != want {
+				t.Fatalf("%s: got %q, want %q", lang, out, want)
+			}
+		})
+		if allocs > matching {
+			t.Errorf("%s: got %v allocs per translation, want %v", lang, allocs, matching)
+		}
+	}
+}
+
+func TestTranslate_staticMessageOverriddenBeforeUse(t *testing.T) {
+	bundle, err := translator.LoadBundleFromFS(translator.SelectedLocalesFS, translator.DefaultLocaleDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bundle.MustAddMessages(language.English,
+		&i18n.Message{ID: "string.email", Other: "bad email"},
+		&i18n.Message{ID: "float.finite", Other: "{{.Field}} must be finite"},
+	)
+	cases := []struct{ id, want string }{
+		{"string.email", "bad email"},
+		{"float.finite", "price must be finite"},
+	}
+	for _, c := range cases {
+		out, err := translator.Translate(bundle, "en", "", c.id, map[string]any{"Field": "price"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if out != c.want {
+			t.Errorf("%s: got %q, want %q", c.id, out, c.want)
+		}
+	}
+}
+
+func TestTranslate_staticMessageOverriddenAfterUse(t *testing.T) {
+	bundle, err := translator.LoadBundleFromFS(translator.SelectedLocalesFS, translator.DefaultLocaleDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, lang := range []string{"en", "zh"} {
+		translator.MustTranslate(bundle, lang, "en", "string.email", nil)
+	}
+	bundle.MustAddMessages(language.English, &i18n.Message{ID: "string.email", Other: "bad email"})
+	bundle.MustAddMessages(language.Chinese, &i18n.Message{ID: "string.email", Other: "{{.Field}} 不是邮箱"})
+	cases := []struct{ lang, want string }{
+		{"en", "bad email"},
+		{"zh", "email 不是邮箱"},
+	}
+	for _, c := range cases {
+		out, err := translator.Translate(bundle, c.lang, "en", "string.email", map[string]any{"Field": "email"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if out != c.want {
+			t.Errorf("%s: got %q, want %q", c.lang, out, c.want)
+		}
+	}
+}
+
+func TestTranslate_staticPseudoMessages(t *testing.T) {
+	bundle, err := translator.LoadBundleFromFS(translator.SelectedLocalesFS, translator.DefaultLocaleDir, translator.WithPseudoLocales())
+	if err != nil {
+		t.Fatal(err)
+	}
+	src := translator.MustTranslate(bundle, "en", "", "string.email", nil)
+	want := translator.PseudoLocalize(translator.PseudoAccentLang, src)
+	if out := translator.MustTranslate(bundle, translator.PseudoAccentLang, "", "string.email", nil); out != want {
+		t.Errorf("got %q, want %q", out, want)
+	}
+}
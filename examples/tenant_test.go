@@ -0,0 +1,250 @@
+package translator_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/jzero-io/protovalidate-translator/translator"
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+)
+
+func newTenantRegistry(t *testing.T, load translator.TenantLoader, capacity int) *translator.TenantRegistry {
+	t.Helper()
+	global, err := translator.LoadBundleFromFS(translator.SelectedLocalesFS, translator.DefaultLocaleDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return translator.NewTenantRegistry(global, load, capacity)
+}
+
+func TestTenantRegistry_dirLoader(t *testing.T) {
+	root := t.TempDir()
+	if err := os.Mkdir(filepath.Join(root, "acme"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	writeLocale(t, filepath.Join(root, "acme"), "en.json", `{"float.lt": "ACME: below {{.Value}} please"}`)
+	registry := newTenantRegistry(t, translator.TenantDirLoader(root), 0)
+
+	cases := []struct {
+		ctx      context.Context
+		id, want string
+	}{
+		{translator.WithTenant(context.Background(), "acme"), "float.lt", "ACME: below 1 please"},
+		{translator.WithTenant(context.Background(), "acme"), "float.gt", "value must be greater than 1"},
+		{translator.WithTenant(context.Background(), "other"), "float.lt", "value must be less than 1"},
+		{context.Background(), "float.lt", "value must be less than 1"},
+	}
+	for _, c := range cases {
+		out, err := registry.Translate(c.ctx, "en", "en", c.id, map[string]any{"Value": 1})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if out != c.want {
+			t.Errorf("%s: got %q, want %q", c.id, out, c.want)
+		}
+	}
+}
+
+func TestTenantRegistry_fsLoaderRejectsPaths(t *testing.T) {
+	fsys := fstest.MapFS{"tenants/acme/en.json": {Data: []byte(`{"a": "A"}`)}}
+	registry := newTenantRegistry(t, translator.TenantFSLoader(fsys, "tenants"), 0)
+	if out, err := registry.Translate(translator.WithTenant(context.Background(), "acme"), "en", "", "a", nil); err != nil || out != "A" {
+		t.Fatalf("got %q, %v", out, err)
+	}
+	if _, err := registry.Translate(translator.WithTenant(context.Background(), "../etc"), "en", "", "a", nil); err == nil {
+		t.Error("expected error for tenant with path separators")
+	}
+}
+
+func TestTenantRegistry_lruEviction(t *testing.T) {
+	var mu sync.Mutex
+	loads := map[string]int{}
+	load := func(tenant string) (*i18n.Bundle, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		loads[tenant]++
+		return nil, nil
+	}
+	registry := newTenantRegistry(t, load, 2)
+	use := func(tenant string) {
+		t.Helper()
+		if _, err := registry.Overlay(translator.WithTenant(context.Background(), tenant)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	use("a")
+	use("b")
+	use("a") // a is now most recently used
+	use("c") // evicts b
+	if registry.Len() != 2 {
+		t.Errorf("expected 2 cached tenants, got %d", registry.Len())
+	}
+	use("a")
+	use("b")
+	if loads["a"] != 1 || loads["b"] != 2 || loads["c"] != 1 {
+		t.Errorf("unexpected loads: %v", loads)
+	}
+	registry.Evict("a")
+	use("a")
+	if loads["a"] != 2 {
+		t.Errorf("expected reload after Evict, got %d loads", loads["a"])
+	}
+}
+
+func TestTenantRegistry_loadErrorIsNotCached(t *testing.T) {
+	fail := true
+	load := func(tenant string) (*i18n.Bundle, error) {
+		if fail {
+			return nil, errors.New("storage unavailable")
+		}
+		return nil, nil
+	}
+	registry := newTenantRegistry(t, load, 0)
+	ctx := translator.WithTenant(context.Background(), "acme")
+	if _, err := registry.Translate(ctx, "en", "", "float.finite", nil); err == nil {
+		t.Fatal("expected load error")
+	}
+	fail = false
+	out, err := registry.Translate(ctx, "en", "", "float.finite", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out != "value must be finite" {
+		t.Errorf("got %q", out)
+	}
+}
+
+func TestTenantRegistry_concurrentFirstLoad(t *testing.T) {
+	var mu sync.Mutex
+	loads := 0
+	release := make(chan struct{})
+	load := func(tenant string) (*i18n.Bundle, error) {
+		mu.Lock()
+		loads++
+		mu.Unlock()
+		<-release
+		return nil, nil
+	}
+	registry := newTenantRegistry(t, load, 0)
+	ctx := translator.WithTenant(context.Background(), "acme")
+
+	const n = 8
+	overlays := make(chan *translator.Overlay, n)
+	var wg sync.WaitGroup
+	for range n {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			overlay, err := registry.Overlay(ctx)
+			if err != nil {
+				t.Error(err)
+			}
+			overlays <- overlay
+		}()
+	}
+	// Let the other requests arrive while the first load is blocked.
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	close(overlays)
+	first := <-overlays
+	for overlay := range overlays {
+		if overlay != first {
+			t.Error("concurrent requests got different overlays")
+		}
+	}
+	if loads != 1 {
+		t.Errorf("got %d loads, want 1", loads)
+	}
+}
+
+func TestTenantRegistry_evictDuringLoad(t *testing.T) {
+	var mu sync.Mutex
+	loads := 0
+	started, release := make(chan struct{}), make(chan struct{})
+	load := func(tenant string) (*i18n.Bundle, error) {
+		mu.Lock()
+		loads++
+		n := loads
+		mu.Unlock()
+		if n == 1 {
+			close(started)
+			<-release
+		}
+		return nil, nil
+	}
+	registry := newTenantRegistry(t, load, 0)
+	ctx := translator.WithTenant(context.Background(), "acme")
+
+	done := make(chan *translator.Overlay)
+	go func() {
+		overlay, err := registry.Overlay(ctx)
+		if err != nil {
+			t.Error(err)
+		}
+		done <- overlay
+	}()
+	<-started
+	registry.Evict("acme")
+	close(release)
+	stale := <-done
+	if n := registry.Len(); n != 0 {
+		t.Errorf("load evicted while in progress was cached: %d tenants", n)
+	}
+	overlay, err := registry.Overlay(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if overlay == stale || loads != 2 {
+		t.Errorf("expected a new load after eviction, got %d loads", loads)
+	}
+}
+
+func TestTenantRegistry_observedWithContextLanguage(t *testing.T) {
+	rec := &recordingObserver{}
+	setObserver(t, rec)
+	fsys := fstest.MapFS{"tenants/acme/zh.json": {Data: []byte(`{"string.email": "租户：邮箱格式不正确"}`)}}
+	registry := newTenantRegistry(t, translator.TenantFSLoader(fsys, "tenants"), 0)
+	ctx := translator.WithLanguage(translator.WithTenant(context.Background(), "acme"), "zh")
+	ctx = translator.WithFallback(ctx, "en")
+
+	out, err := registry.TranslateCtx(ctx, "string.email", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out != "租户：邮箱格式不正确" {
+		t.Errorf("got %q", out)
+	}
+	if _, err := registry.Translate(ctx, "zh", "en", "float.finite", nil); err != nil {
+		t.Fatal(err)
+	}
+	if len(rec.events) != 2 || rec.events[0].ID != "string.email" || rec.events[1].ID != "float.finite" {
+		t.Errorf("expected an event per translation, got %+v", rec.events)
+	}
+}
+
+func TestTenantRegistry_regionalMessagesOfGlobal(t *testing.T) {
+	requireLocale(t, "zh-TW")
+	fsys := fstest.MapFS{"tenants/acme/zh.json": {Data: []byte(`{"string.email": "租户：邮箱格式不正确"}`)}}
+	registry := newTenantRegistry(t, translator.TenantFSLoader(fsys, "tenants"), 0)
+	ctx := translator.WithTenant(context.Background(), "acme")
+	cases := []struct{ lang, want string }{
+		{"zh", "租户：邮箱格式不正确"},
+		{"zh-TW", translator.MustTranslateDefault("zh-TW", "string.email", nil)},
+	}
+	for _, c := range cases {
+		out, err := registry.Translate(ctx, c.lang, "en", "string.email", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if out != c.want {
+			t.Errorf("%s: got %q, want %q", c.lang, out, c.want)
+		}
+	}
+}
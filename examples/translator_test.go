@@ -81,7 +81,7 @@ func TestTranslate_missingIdReturnsId(t *testing.T) {
 }
 
 func TestLoadBundleFromFS(t *testing.T) {
-	bundle, err := translator.LoadBundleFromFS(translator.LocalesFS, translator.DefaultLocaleDir)
+	bundle, err := translator.LoadBundleFromFS(translator.SelectedLocalesFS, translator.DefaultLocaleDir)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -175,7 +175,7 @@ func TestAddDefaultMessage_invalidLang_noPanic(t *testing.T) {
 }
 
 func TestBundleCustomizer_extendsBundle(t *testing.T) {
-	bundle, err := translator.LoadBundleFromFS(translator.LocalesFS, translator.DefaultLocaleDir)
+	bundle, err := translator.LoadBundleFromFS(translator.SelectedLocalesFS, translator.DefaultLocaleDir)
 	if err != nil {
 		t.Fatal(err)
 	}
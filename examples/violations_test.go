@@ -0,0 +1,195 @@
+package translator_test
+
+import (
+	"errors"
+	"testing"
+
+	"buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	"buf.build/go/protovalidate"
+	"github.com/jzero-io/protovalidate-translator/examples/translate/testdata/pb"
+	"github.com/jzero-io/protovalidate-translator/translator"
+	"google.golang.org/protobuf/proto"
+)
+
+func fieldPath(elements ...*validate.FieldPathElement) *validate.FieldPath {
+	return validate.FieldPath_builder{Elements: elements}.Build()
+}
+
+func field(name string, number int32) *validate.FieldPathElement {
+	return validate.FieldPathElement_builder{FieldName: proto.String(name), FieldNumber: proto.Int32(number)}.Build()
+}
+
+func newTestViolations() *validate.Violations {
+	index := field("items", 1)
+	index.SetIndex(2)
+	key := field("attributes", 4)
+	key.SetStringKey("color")
+	return validate.Violations_builder{Violations: []*validate.Violation{
+		validate.Violation_builder{
+			Field:   fieldPath(index, field("name", 2)),
+			RuleId:  proto.String("string.min_len"),
+			Message: proto.String("value length must be at least 3 characters"),
+		}.Build(),
+		validate.Violation_builder{
+			Field:   fieldPath(key),
+			RuleId:  proto.String("string.email"),
+			Message: proto.String("value must be a valid email address"),
+		}.Build(),
+		// A custom CEL rule keeps its message.
+		validate.Violation_builder{RuleId: proto.String("order.total"), Message: proto.String("total must be positive")}.Build(),
+		// A message that does not match the English template keeps its message.
+		validate.Violation_builder{RuleId: proto.String("float.lt"), Message: proto.String("too large")}.Build(),
+	}}.Build()
+}
+
+func TestTranslateViolations(t *testing.T) {
+	bundle, err := translator.DefaultBundle()
+	if err != nil {
+		t.Fatal(err)
+	}
+	in := newTestViolations()
+	out, err := translator.TranslateViolations(bundle, "zh", "en", in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		translator.MustTranslate(bundle, "zh", "en", "string.min_len", map[string]any{"Value": 3}),
+		translator.MustTranslate(bundle, "zh", "en", "string.email", nil),
+		"total must be positive",
+		"too large",
+	}
+	for i, v := range out.GetViolations() {
+		if v.GetMessage() != want[i] {
+			t.Errorf("violation %d: got %q, want %q", i, v.GetMessage(), want[i])
+		}
+		if !proto.Equal(v.GetField(), in.GetViolations()[i].GetField()) || v.GetRuleId() != in.GetViolations()[i].GetRuleId() {
+			t.Errorf("violation %d: only the message should change", i)
+		}
+	}
+	if in.GetViolations()[0].GetMessage() != "value length must be at least 3 characters" {
+		t.Error("input was modified")
+	}
+}
+
+func TestTranslateViolations_fieldPaths(t *testing.T) {
+	bundle, err := translator.DefaultBundle()
+	if err != nil {
+		t.Fatal(err)
+	}
+	in := newTestViolations()
+	for _, v := range in.GetViolations() {
+		if got, want := translator.ProtoFieldPath.FormatFieldPath("en", v.GetField()), protovalidate.FieldPathString(v.GetField()); got != want {
+			t.Errorf("ProtoFieldPath: got %q, want %q", got, want)
+		}
+	}
+
+	out, err := translator.TranslateViolations(bundle, "en", "", in, translator.WithFieldPaths(translator.ProtoFieldPath))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		"items[2].name: value length must be at least 3 characters",
+		`attributes["color"]: value must be a valid email address`,
+		"total must be positive",
+		"too large",
+	}
+	for i, v := range out.GetViolations() {
+		if v.GetMessage() != want[i] {
+			t.Errorf("violation %d: got %q, want %q", i, v.GetMessage(), want[i])
+		}
+	}
+}
+
+func TestTranslateViolationsBytes(t *testing.T) {
+	bundle, err := translator.DefaultBundle()
+	if err != nil {
+		t.Fatal(err)
+	}
+	in, err := proto.Marshal(newTestViolations())
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := translator.TranslateViolationsBytes(bundle, "zh-TW", "en", in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var out validate.Violations
+	if err := proto.Unmarshal(b, &out); err != nil {
+		t.Fatal(err)
+	}
+	if want := translator.MustTranslate(bundle, "zh-TW", "en", "string.email", nil); out.GetViolations()[1].GetMessage() != want {
+		t.Errorf("got %q, want %q", out.GetViolations()[1].GetMessage(), want)
+	}
+	if _, err := translator.TranslateViolationsBytes(bundle, "zh", "en", []byte{0xff}); err == nil {
+		t.Error("invalid input should fail")
+	}
+}
+
+func TestTranslateViolations_validationError(t *testing.T) {
+	bundle, err := translator.DefaultBundle()
+	if err != nil {
+		t.Fatal(err)
+	}
+	validator, err := protovalidate.New(protovalidate.WithMessages(&pb.User{}, &pb.Order{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, msg := range []proto.Message{
+		&pb.User{Email: "not-an-email", Age: 10, Name: "a"},
+		&pb.Order{Id: "a"},
+	} {
+		var valErr *protovalidate.ValidationError
+		if !errors.As(validator.Validate(msg), &valErr) {
+			t.Fatalf("%T: expected a validation error", msg)
+		}
+		out, err := translator.TranslateViolations(bundle, "zh", "en", valErr.ToProto())
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i, v := range valErr.Violations {
+			data := map[string]any{"Value": normalizeRuleValue(v.RuleValue)}
+			want := translator.MustTranslateDefault("zh", v.Proto.GetRuleId(), data)
+			if got := out.GetViolations()[i].GetMessage(); got != want {
+				t.Errorf("%s: got %q, want %q", v.Proto.GetRuleId(), got, want)
+			}
+		}
+	}
+}
+
+func TestTranslateViolation_repeatedField(t *testing.T) {
+	bundle, err := translator.DefaultBundle()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, id := range []string{"double.gt_lt", "double.gte_lte"} {
+		// The template renders one value for both bounds, so a message with equal
+		// bounds round-trips.
+		data := map[string]any{"Value": "5"}
+		v := validate.Violation_builder{
+			RuleId:  proto.String(id),
+			Message: proto.String(translator.MustTranslate(bundle, "en", "", id, data)),
+		}.Build()
+		got, err := translator.TranslateViolation(bundle, "zh", "en", v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := translator.MustTranslate(bundle, "zh", "en", id, data); got != want {
+			t.Errorf("%s: got %q, want %q", id, got, want)
+		}
+	}
+
+	// Different bounds cannot be rendered by the translation, so the message is kept.
+	for id, message := range map[string]string{
+		"double.gt_lt":   "value must be greater than 1 and less than 10",
+		"double.gte_lte": "value must be greater than or equal to 1 and less than or equal to 10",
+	} {
+		v := validate.Violation_builder{RuleId: proto.String(id), Message: proto.String(message)}.Build()
+		got, err := translator.TranslateViolation(bundle, "zh", "en", v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != message {
+			t.Errorf("%s: got %q, want the original message", id, got)
+		}
+	}
+}
@@ -2,6 +2,9 @@ import re
 import json
 import os
 
+# 非规则文案（字段路径、字段标签）不来自 validate.proto，重新生成时从现有 en.json 保留
+KEEP_PREFIXES = ("field_path.", "label.")
+
 def main():
     base = os.path.dirname(os.path.abspath(__file__))
     proto_path = os.path.join(base, "../third_party/buf/validate/validate.proto")
@@ -81,9 +84,15 @@ def main():
         # 无 message 的（如 example）不写入，保持输出只含有效文案
 
     print(f"found {len(result)} messages")
-    # 保存到文件（go-i18n JSON 格式）
-    items = [{"id": k, "translation": v} for k, v in sorted(result.items())]
     out_path = os.path.join(base, "../translator/locales/en.json")
+    kept = {}
+    if os.path.exists(out_path):
+        with open(out_path, "r", encoding="utf-8") as f:
+            for item in json.load(f):
+                if item["id"].startswith(KEEP_PREFIXES) and item["id"] not in result:
+                    kept[item["id"]] = item
+    # 保存到文件（go-i18n JSON 格式）
+    items = [kept.get(k) or {"id": k, "translation": result[k]} for k in sorted(result.keys() | kept.keys())]
     with open(out_path, "w", encoding="utf-8") as f:
         json.dump(items, f, indent=2, ensure_ascii=False)
 
@@ -0,0 +1,240 @@
+package translator
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+)
+
+// BatchItem is a message id and its template data, as translated by TranslateBatch.
+type BatchItem struct {
+	ID   string
+	Data map[string]any
+}
+
+// BatchOption configures TranslateBatch.
+type BatchOption func(*batchOptions)
+
+type batchOptions struct {
+	parallelism int
+}
+
+// WithParallelism translates with up to n goroutines. The default is 1.
+func WithParallelism(n int) BatchOption {
+	return func(o *batchOptions) {
+		o.parallelism = n
+	}
+}
+
+// TranslateBatch translates many items with the same language and fallback language,
+// e.g. the violations of a bulk request. The languages are resolved once per batch,
+// and items with the same id and data are translated once. Results are in input order.
+// The first error stops the batch. The Observer sees an event per item; items
+// translated once for several inputs report the same Start and Duration.
+func TranslateBatch(bundle *i18n.Bundle, lang string, defaultLang string, items []BatchItem, opts ...BatchOption) ([]Result, error) {
+	o := batchOptions{parallelism: 1}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	langs := []string{lang, defaultLang}
+	chain := resolveChain(bundle, langs)
+	obs := currentObserver()
+
+	// unique holds the index of the first occurrence of each distinct item;
+	// slots maps every item to its position in unique.
+	var unique []int
+	slots := make([]int, len(items))
+	seen := make(map[string]int, len(items))
+	for i, item := range items {
+		key := batchKey(item)
+		slot, ok := seen[key]
+		if !ok {
+			slot = len(unique)
+			seen[key] = slot
+			unique = append(unique, i)
+		}
+		slots[i] = slot
+	}
+
+	translated := make([]Result, len(unique))
+	timings := make([]batchTiming, len(unique))
+	translate := func(slot int) error {
+		item := items[unique[slot]]
+		start := time.Now()
+		res, err := lookupResolved(chain, item.ID, item.Data)
+		timings[slot] = batchTiming{start: start, duration: time.Since(start), err: err, done: true}
+		if err != nil {
+			return fmt.Errorf("item %d (%s): %w", unique[slot], item.ID, err)
+		}
+		translated[slot] = res
+		return nil
+	}
+	err := runBatch(len(unique), o.parallelism, translate)
+	if obs != nil {
+		for i, slot := range slots {
+			if t := timings[slot]; t.done {
+				obs.ObserveTranslation(context.Background(), newEvent(langs, items[i].ID, translated[slot], t.err, t.start, t.duration))
+			}
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]Result, len(items))
+	for i, slot := range slots {
+		results[i] = translated[slot]
+	}
+	return results, nil
+}
+
+// batchTiming records when a distinct item was translated, for Observer events.
+type batchTiming struct {
+	start    time.Time
+	duration time.Duration
+	err      error
+	done     bool
+}
+
+// chainLang is a language of a fallback chain with its localizer.
+type chainLang struct {
+	lang      string
+	localizer *cachedLocalizer
+}
+
+// resolveChain returns the distinct non-empty languages of langs with their localizers.
+func resolveChain(bundle *i18n.Bundle, langs []string) []chainLang {
+	if bundle == nil {
+		return nil
+	}
+	var chain []chainLang
+	for i, lang := range langs {
+		if lang == "" || slices.Contains(langs[:i], lang) {
+			continue
+		}
+		chain = append(chain, chainLang{lang: lang, localizer: localizers.get(bundle, lang)})
+	}
+	return chain
+}
+
+// lookupResolved is like lookupChain with the languages resolved by resolveChain.
+func lookupResolved(chain []chainLang, id string, data map[string]any) (Result, error) {
+	for depth, l := range chain {
+		msg, tag, ok, err := l.localizer.localize(id, data)
+		if err != nil {
+			return Result{}, err
+		}
+		if ok {
+			return Result{Message: msg, Lang: tag, Fallback: depth > 0, FallbackDepth: depth}, nil
+		}
+		reportMissing(id, l.lang)
+	}
+	return Result{Message: id, Missing: true}, nil
+}
+
+// runBatch calls fn for 0..n-1 with up to parallelism goroutines and returns the first error.
+func runBatch(n int, parallelism int, fn func(i int) error) error {
+	if parallelism > n {
+		parallelism = n
+	}
+	if parallelism <= 1 {
+		for i := 0; i < n; i++ {
+			if err := fn(i); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	var (
+		wg       sync.WaitGroup
+		errOnce  sync.Once
+		firstErr error
+		next     = make(chan int)
+		stop     = make(chan struct{})
+	)
+	for w := 0; w < parallelism; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range next {
+				if err := fn(i); err != nil {
+					errOnce.Do(func() {
+						firstErr = err
+						close(stop)
+					})
+				}
+			}
+		}()
+	}
+feed:
+	for i := 0; i < n; i++ {
+		select {
+		case next <- i:
+		case <-stop:
+			break feed
+		}
+	}
+	close(next)
+	wg.Wait()
+	return firstErr
+}
+
+// batchKey identifies items that render the same message.
+func batchKey(item BatchItem) string {
+	if len(item.Data) == 0 {
+		return item.ID
+	}
+	var b strings.Builder
+	b.WriteString(item.ID)
+	if len(item.Data) == 1 {
+		for k, v := range item.Data {
+			writeBatchValue(&b, k, v)
+		}
+		return b.String()
+	}
+	keys := make([]string, 0, len(item.Data))
+	for k := range item.Data {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		writeBatchValue(&b, k, item.Data[k])
+	}
+	return b.String()
+}
+
+func writeBatchValue(b *strings.Builder, key string, value any) {
+	b.WriteByte(0)
+	b.WriteString(key)
+	b.WriteByte('=')
+	switch v := value.(type) {
+	case string:
+		b.WriteString("s")
+		b.WriteString(strconv.Itoa(len(v)))
+		b.WriteByte(':')
+		b.WriteString(v)
+	case int:
+		b.WriteString("i")
+		b.WriteString(strconv.Itoa(v))
+	case int64:
+		b.WriteString("l")
+		b.WriteString(strconv.FormatInt(v, 10))
+	case uint64:
+		b.WriteString("u")
+		b.WriteString(strconv.FormatUint(v, 10))
+	case float64:
+		b.WriteString("f")
+		b.WriteString(strconv.FormatFloat(v, 'g', -1, 64))
+	case bool:
+		b.WriteString(strconv.FormatBool(v))
+	default:
+		fmt.Fprintf(b, "%#v", v)
+	}
+}
@@ -1,75 +1,300 @@
 package translator
 
 import (
+	"encoding/json"
 	"fmt"
 	"io/fs"
 	"os"
 	"path"
-	"path/filepath"
 	"strings"
+	"text/template"
 
+	"github.com/BurntSushi/toml"
 	"github.com/nicksnyder/go-i18n/v2/i18n"
 	"golang.org/x/text/language"
+	"gopkg.in/yaml.v3"
 )
 
-// NewBundle creates a go-i18n bundle.
+// localeFormats maps locale file extensions to their unmarshalers.
+var localeFormats = map[string]i18n.UnmarshalFunc{
+	"json": json.Unmarshal,
+	"yaml": yaml.Unmarshal,
+	"yml":  yaml.Unmarshal,
+	"toml": toml.Unmarshal,
+}
+
+// LoadOption configures LoadBundleFromFS and LoadBundleFromDir.
+type LoadOption func(*loadOptions)
+
+type loadOptions struct {
+	pseudo    bool
+	validate  bool
+	recursive bool
+	include   []string
+	exclude   []string
+}
+
+func newLoadOptions(opts []LoadOption) loadOptions {
+	var o loadOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// WithPseudoLocales derives the pseudo-locales PseudoAccentLang and PseudoBidiLang
+// from the DefaultLang locale file while the bundle is loaded.
+func WithPseudoLocales() LoadOption {
+	return func(o *loadOptions) {
+		o.pseudo = true
+	}
+}
+
+// WithTemplateValidation parses every message template while the bundle is loaded,
+// so that syntax errors are reported by the loader instead of at translation time.
+func WithTemplateValidation() LoadOption {
+	return func(o *loadOptions) {
+		o.validate = true
+	}
+}
+
+// WithRecursive makes the loaders walk subdirectories, e.g. locales/zh/validate.json.
+// A file's language comes from its name (zh.json, validate.zh.json) or, when the
+// name is not a language with plural rules, from its parent directory (zh/validate.json).
+func WithRecursive() LoadOption {
+	return func(o *loadOptions) {
+		o.recursive = true
+	}
+}
+
+// WithInclude only loads files matching at least one of the glob patterns (see path.Match).
+// Patterns containing "/" match the slash-separated path relative to the loaded directory;
+// other patterns match the file name.
+func WithInclude(patterns ...string) LoadOption {
+	return func(o *loadOptions) {
+		o.include = append(o.include, patterns...)
+	}
+}
+
+// WithExclude skips files matching any of the glob patterns. See WithInclude.
+func WithExclude(patterns ...string) LoadOption {
+	return func(o *loadOptions) {
+		o.exclude = append(o.exclude, patterns...)
+	}
+}
+
+// NewBundle creates a go-i18n bundle that can parse JSON, YAML (.yaml, .yml) and TOML message files.
 func NewBundle() *i18n.Bundle {
-	return i18n.NewBundle(language.Und)
+	bundle := i18n.NewBundle(language.Und)
+	for format, fn := range localeFormats {
+		bundle.RegisterUnmarshalFunc(format, fn)
+	}
+	return bundle
+}
+
+// LoadBundleFromFS loads locale files from an fs.FS (e.g., embed.FS).
+// The directory should contain files like en.json, zh.yaml, zh-TW.toml, etc.;
+// formats may be mixed.
+func LoadBundleFromFS(fsys fs.FS, dir string, opts ...LoadOption) (*i18n.Bundle, error) {
+	return loadBundle(fsys, dir, dir, newLoadOptions(opts))
+}
+
+// LoadBundleFromDir loads locale files from an OS directory. See LoadBundleFromFS.
+func LoadBundleFromDir(dir string, opts ...LoadOption) (*i18n.Bundle, error) {
+	if _, err := os.ReadDir(dir); err != nil {
+		return nil, err
+	}
+	return loadBundle(os.DirFS(dir), ".", dir, newLoadOptions(opts))
+}
+
+// LoadMessageFilesFromFS parses the locale files that LoadBundleFromFS would load,
+// without building a bundle, e.g. for tools that convert locales to other formats.
+// Each file's Tag is its language. WithTemplateValidation applies; WithPseudoLocales does not.
+func LoadMessageFilesFromFS(fsys fs.FS, dir string, opts ...LoadOption) ([]*i18n.MessageFile, error) {
+	return loadMessageFiles(fsys, dir, dir, newLoadOptions(opts))
+}
+
+// LoadMessageFilesFromDir parses locale files from an OS directory. See LoadMessageFilesFromFS.
+func LoadMessageFilesFromDir(dir string, opts ...LoadOption) ([]*i18n.MessageFile, error) {
+	if _, err := os.ReadDir(dir); err != nil {
+		return nil, err
+	}
+	return loadMessageFiles(os.DirFS(dir), ".", dir, newLoadOptions(opts))
 }
 
-// LoadBundleFromFS loads locale JSON files from an fs.FS (e.g., embed.FS).
-// The directory should contain files like en.json, zh.json, etc.
-func LoadBundleFromFS(fsys fs.FS, dir string) (*i18n.Bundle, error) {
-	entries, err := fs.ReadDir(fsys, dir)
+// loadBundle loads the locale files under root in fsys; dir is the directory name used in errors.
+func loadBundle(fsys fs.FS, root string, dir string, o loadOptions) (*i18n.Bundle, error) {
+	files, err := loadMessageFiles(fsys, root, dir, o)
 	if err != nil {
 		return nil, err
 	}
 	bundle := NewBundle()
-	loaded := 0
-	for _, entry := range entries {
-		if entry.IsDir() {
-			continue
+	for _, file := range files {
+		if err := bundle.AddMessages(file.Tag, file.Messages...); err != nil {
+			return nil, fmt.Errorf("%s: %w", file.Path, err)
 		}
-		name := entry.Name()
-		if !strings.HasSuffix(name, ".json") {
-			continue
-		}
-		filePath := path.Join(dir, name)
-		if _, err := bundle.LoadMessageFileFS(fsys, filePath); err != nil {
-			return nil, err
-		}
-		loaded++
 	}
-	if loaded == 0 {
-		return nil, fmt.Errorf("no locale files found in %s", dir)
+	if o.pseudo {
+		source := language.Make(DefaultLang)
+		for _, file := range files {
+			if file.Tag != source {
+				continue
+			}
+			if err := AddPseudoMessages(bundle, file.Messages); err != nil {
+				return nil, err
+			}
+		}
 	}
 	return bundle, nil
 }
 
-// LoadBundleFromDir loads locale JSON files from an OS directory.
-func LoadBundleFromDir(dir string) (*i18n.Bundle, error) {
-	entries, err := os.ReadDir(dir)
+// loadMessageFiles parses the locale files under root in fsys and validates their templates if requested.
+func loadMessageFiles(fsys fs.FS, root string, dir string, o loadOptions) ([]*i18n.MessageFile, error) {
+	names, err := listLocaleFiles(fsys, root, o)
 	if err != nil {
 		return nil, err
 	}
-	bundle := NewBundle()
-	loaded := 0
-	for _, entry := range entries {
+	if len(names) == 0 {
+		return nil, fmt.Errorf("no locale files found in %s", dir)
+	}
+	files := make([]*i18n.MessageFile, 0, len(names))
+	for _, name := range names {
+		filePath := path.Join(dir, name)
+		buf, err := fs.ReadFile(fsys, path.Join(root, name))
+		if err != nil {
+			return nil, err
+		}
+		file, err := i18n.ParseMessageFileBytes(buf, filePath, localeFormats)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", filePath, err)
+		}
+		file.Tag = localeTag(name)
+		if o.validate {
+			if err := validateTemplates(file); err != nil {
+				return nil, err
+			}
+		}
+		files = append(files, file)
+	}
+	return files, nil
+}
+
+// listLocaleFiles returns the locale files under root, as slash-separated paths relative to root.
+func listLocaleFiles(fsys fs.FS, root string, o loadOptions) ([]string, error) {
+	var names []string
+	err := fs.WalkDir(fsys, root, func(p string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
 		if entry.IsDir() {
-			continue
+			if p != root && !o.recursive {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		if !isLocaleFile(entry.Name()) {
+			return nil
+		}
+		name := p
+		if root != "." {
+			name = strings.TrimPrefix(p, root+"/")
+		}
+		ok, err := o.matches(name)
+		if err != nil {
+			return err
+		}
+		if ok {
+			names = append(names, name)
+		}
+		return nil
+	})
+	return names, err
+}
+
+func (o loadOptions) matches(name string) (bool, error) {
+	if len(o.include) > 0 {
+		ok, err := matchAny(o.include, name)
+		if err != nil || !ok {
+			return false, err
+		}
+	}
+	excluded, err := matchAny(o.exclude, name)
+	return !excluded, err
+}
+
+func matchAny(patterns []string, name string) (bool, error) {
+	for _, pattern := range patterns {
+		target := name
+		if !strings.Contains(pattern, "/") {
+			target = path.Base(name)
 		}
-		name := entry.Name()
-		if !strings.HasSuffix(name, ".json") {
+		ok, err := path.Match(pattern, target)
+		if err != nil {
+			return false, fmt.Errorf("pattern %q: %w", pattern, err)
+		}
+		if ok {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// localeTag infers the language of a locale file from its name (zh.json, validate.zh.json)
+// or its parent directory (zh/validate.json), falling back to go-i18n's lenient parsing
+// of the name. Names such as api.json and directories such as api/ parse as languages
+// without plural rules, so the name or directory with plural rules wins.
+func localeTag(name string) language.Tag {
+	base := strings.TrimSuffix(path.Base(name), path.Ext(name))
+	if i := strings.LastIndex(base, "."); i >= 0 {
+		base = base[i+1:]
+	}
+	candidates := []string{base}
+	if parent := path.Base(path.Dir(name)); parent != "." {
+		candidates = append(candidates, parent)
+	}
+	var valid []language.Tag
+	for _, c := range candidates {
+		tag, err := language.Parse(c)
+		if err != nil || tag == language.Und {
 			continue
 		}
-		filePath := filepath.Join(dir, name)
-		if _, err := bundle.LoadMessageFile(filePath); err != nil {
-			return nil, err
+		if hasPluralRule(tag) {
+			return tag
 		}
-		loaded++
+		valid = append(valid, tag)
 	}
-	if loaded == 0 {
-		return nil, fmt.Errorf("no locale files found in %s", dir)
+	if len(valid) > 0 {
+		return valid[0]
 	}
-	return bundle, nil
+	return language.Make(base)
+}
+
+// hasPluralRule reports whether go-i18n can add messages for tag.
+func hasPluralRule(tag language.Tag) bool {
+	return i18n.NewBundle(language.Und).AddMessages(tag) == nil
+}
+
+// isLocaleFile reports whether name is a locale file picked up by the directory loaders.
+func isLocaleFile(name string) bool {
+	ext := path.Ext(name)
+	if ext == "" {
+		return false
+	}
+	_, ok := localeFormats[strings.TrimPrefix(ext, ".")]
+	return ok
+}
+
+func validateTemplates(file *i18n.MessageFile) error {
+	for _, msg := range file.Messages {
+		left, right := msg.LeftDelim, msg.RightDelim
+		for _, src := range []string{msg.Zero, msg.One, msg.Two, msg.Few, msg.Many, msg.Other} {
+			if src == "" {
+				continue
+			}
+			if _, err := template.New(msg.ID).Delims(left, right).Parse(src); err != nil {
+				return fmt.Errorf("%s: message %q: %w", file.Path, msg.ID, err)
+			}
+		}
+	}
+	return nil
 }
@@ -0,0 +1,137 @@
+package translator
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+	"github.com/nicksnyder/go-i18n/v2/i18n/template"
+	"golang.org/x/text/language"
+)
+
+//go:generate go run ./internal/gencatalog -dir locales -out .
+
+// CatalogBuildTag is the build tag that compiles the generated catalog into the package.
+// With it, DefaultBundle is built from Go literals instead of decoding the embedded
+// locale files, and templates of the form "text {{.Field}} text" are executed without
+// text/template. Regenerate the catalog with go generate after editing locales/*.json.
+const CatalogBuildTag = "translator_catalog"
+
+// catalogLang is the generated catalog of one language.
+type catalogLang struct {
+	tag      language.Tag
+	messages []*i18n.Message
+}
+
+var (
+	catalogMu        sync.Mutex
+	catalogLangs     []catalogLang
+	catalogTemplates = map[string][]templatePart{}
+)
+
+// templatePart is literal text, or the value of a data field when field is set.
+type templatePart struct {
+	text  string
+	field string
+}
+
+// registerCatalog is called by the generated catalog files.
+func registerCatalog(lang string, messages []*i18n.Message, templates map[string][]templatePart) {
+	catalogMu.Lock()
+	defer catalogMu.Unlock()
+	messageParser = staticParser{next: catalogParser{}}
+	catalogLangs = append(catalogLangs, catalogLang{tag: language.MustParse(lang), messages: messages})
+	for src, parts := range templates {
+		catalogTemplates[src] = parts
+	}
+}
+
+// catalogBundle returns a bundle with the generated catalog, or nil without one.
+func catalogBundle() (*i18n.Bundle, error) {
+	catalogMu.Lock()
+	defer catalogMu.Unlock()
+	if len(catalogLangs) == 0 {
+		return nil, nil
+	}
+	bundle := NewBundle()
+	for _, l := range catalogLangs {
+		if err := bundle.AddMessages(l.tag, l.messages...); err != nil {
+			return nil, fmt.Errorf("catalog %s: %w", l.tag, err)
+		}
+	}
+	return bundle, nil
+}
+
+// messageParser is the template parser used by Translate. It uses the generated
+// catalog when that is compiled in.
+var messageParser template.Parser = staticParser{}
+
+// catalogParser executes precompiled templates and falls back to text/template.
+type catalogParser struct{}
+
+func (catalogParser) Cacheable() bool { return true }
+
+func (catalogParser) Parse(src, leftDelim, rightDelim string) (template.ParsedTemplate, error) {
+	fallback := &template.TextParser{}
+	if (leftDelim != "" && leftDelim != "{{") || (rightDelim != "" && rightDelim != "}}") {
+		return fallback.Parse(src, leftDelim, rightDelim)
+	}
+	catalogMu.Lock()
+	parts, ok := catalogTemplates[src]
+	catalogMu.Unlock()
+	if !ok {
+		return fallback.Parse(src, leftDelim, rightDelim)
+	}
+	return &compiledTemplate{src: src, parts: parts}, nil
+}
+
+// compiledTemplate renders map data from its parts. Other data, and values that
+// text/template prints differently from fmt (pointers, functions, channels),
+// are rendered with text/template, parsed on first use.
+type compiledTemplate struct {
+	src   string
+	parts []templatePart
+
+	once sync.Once
+	text template.ParsedTemplate
+	err  error
+}
+
+func (t *compiledTemplate) executeText(data any) (string, error) {
+	t.once.Do(func() {
+		t.text, t.err = (&template.TextParser{}).Parse(t.src, "", "")
+	})
+	if t.err != nil {
+		return "", t.err
+	}
+	return t.text.Execute(data)
+}
+
+func (t *compiledTemplate) Execute(data any) (string, error) {
+	m, ok := data.(map[string]any)
+	if !ok {
+		return t.executeText(data)
+	}
+	var b strings.Builder
+	for _, part := range t.parts {
+		if part.field == "" {
+			b.WriteString(part.text)
+			continue
+		}
+		switch v := m[part.field].(type) {
+		case nil:
+			b.WriteString("<no value>")
+		case string:
+			b.WriteString(v)
+		default:
+			switch reflect.ValueOf(v).Kind() {
+			case reflect.Pointer, reflect.Func, reflect.Chan:
+				return t.executeText(data)
+			}
+			fmt.Fprint(&b, v)
+		}
+	}
+	return b.String(), nil
+}
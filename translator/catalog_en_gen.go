@@ -0,0 +1,341 @@
+// Code generated by gencatalog from locales/en.json. DO NOT EDIT.
+
+//go:build translator_catalog
+
+package translator
+
+import "github.com/nicksnyder/go-i18n/v2/i18n"
+
+func init() {
+	registerCatalog("en", []*i18n.Message{
+		{ID: "bool.const", Other: "value must equal {{.Value}}"},
+		{ID: "bytes.const", Other: "value must be {{.Value}}"},
+		{ID: "bytes.contains", Other: "value does not contain {{.Value}}"},
+		{ID: "bytes.in", Other: "value must be in list {{.Value}}"},
+		{ID: "bytes.ip", Other: "value must be a valid IP address"},
+		{ID: "bytes.ip_empty", Other: "value is empty, which is not a valid IP address"},
+		{ID: "bytes.ipv4", Other: "value must be a valid IPv4 address"},
+		{ID: "bytes.ipv4_empty", Other: "value is empty, which is not a valid IPv4 address"},
+		{ID: "bytes.ipv6", Other: "value must be a valid IPv6 address"},
+		{ID: "bytes.ipv6_empty", Other: "value is empty, which is not a valid IPv6 address"},
+		{ID: "bytes.len", Other: "value length must be {{.Value}} bytes"},
+		{ID: "bytes.max_len", Other: "value must be at most {{.Value}} bytes"},
+		{ID: "bytes.min_len", Other: "value length must be at least {{.Value}} bytes"},
+		{ID: "bytes.not_in", Other: "value must not be in list {{.Value}}"},
+		{ID: "bytes.pattern", Other: "value must match regex pattern {{.Value}}"},
+		{ID: "bytes.prefix", Other: "value does not have prefix {{.Value}}"},
+		{ID: "bytes.suffix", Other: "value does not have suffix {{.Value}}"},
+		{ID: "double.const", Other: "value must equal {{.Value}}"},
+		{ID: "double.finite", Other: "value must be finite"},
+		{ID: "double.gt", Other: "value must be greater than {{.Value}}"},
+		{ID: "double.gt_lt", Other: "value must be greater than {{.Value}} and less than {{.Value}}"},
+		{ID: "double.gt_lt_exclusive", Other: "value must be greater than {{.Value}} or less than {{.Value}}"},
+		{ID: "double.gt_lte", Other: "value must be greater than {{.Value}} and less than or equal to {{.Value}}"},
+		{ID: "double.gt_lte_exclusive", Other: "value must be greater than {{.Value}} or less than or equal to {{.Value}}"},
+		{ID: "double.gte", Other: "value must be greater than or equal to {{.Value}}"},
+		{ID: "double.gte_lt", Other: "value must be greater than or equal to {{.Value}} and less than {{.Value}}"},
+		{ID: "double.gte_lt_exclusive", Other: "value must be greater than or equal to {{.Value}} or less than {{.Value}}"},
+		{ID: "double.gte_lte", Other: "value must be greater than or equal to {{.Value}} and less than or equal to {{.Value}}"},
+		{ID: "double.gte_lte_exclusive", Other: "value must be greater than or equal to {{.Value}} or less than or equal to {{.Value}}"},
+		{ID: "double.in", Other: "value must be in list {{.Value}}"},
+		{ID: "double.lt", Other: "value must be less than {{.Value}}"},
+		{ID: "double.lte", Other: "value must be less than or equal to {{.Value}}"},
+		{ID: "double.not_in", Other: "value must not be in list {{.Value}}"},
+		{ID: "duration.const", Other: "value must equal {{.Value}}"},
+		{ID: "duration.gt", Other: "value must be greater than {{.Value}}"},
+		{ID: "duration.gt_lt", Other: "value must be greater than {{.Value}} and less than {{.Value}}"},
+		{ID: "duration.gt_lt_exclusive", Other: "value must be greater than {{.Value}} or less than {{.Value}}"},
+		{ID: "duration.gt_lte", Other: "value must be greater than {{.Value}} and less than or equal to {{.Value}}"},
+		{ID: "duration.gt_lte_exclusive", Other: "value must be greater than {{.Value}} or less than or equal to {{.Value}}"},
+		{ID: "duration.gte", Other: "value must be greater than or equal to {{.Value}}"},
+		{ID: "duration.gte_lt", Other: "value must be greater than or equal to {{.Value}} and less than {{.Value}}"},
+		{ID: "duration.gte_lt_exclusive", Other: "value must be greater than or equal to {{.Value}} or less than {{.Value}}"},
+		{ID: "duration.gte_lte", Other: "value must be greater than or equal to {{.Value}} and less than or equal to {{.Value}}"},
+		{ID: "duration.gte_lte_exclusive", Other: "value must be greater than or equal to {{.Value}} or less than or equal to {{.Value}}"},
+		{ID: "duration.in", Other: "value must be in list {{.Value}}"},
+		{ID: "duration.lt", Other: "value must be less than {{.Value}}"},
+		{ID: "duration.lte", Other: "value must be less than or equal to {{.Value}}"},
+		{ID: "duration.not_in", Other: "value must not be in list {{.Value}}"},
+		{ID: "enum.const", Other: "value must equal {{.Value}}"},
+		{ID: "enum.in", Other: "value must be in list {{.Value}}"},
+		{ID: "enum.not_in", Other: "value must not be in list {{.Value}}"},
+		{ID: "field_path.index", Other: "#{{.Index}}"},
+		{ID: "field_path.key", Other: "\"{{.Key}}\""},
+		{ID: "field_path.separator", Other: " › "},
+		{ID: "fixed32.const", Other: "value must equal {{.Value}}"},
+		{ID: "fixed32.gt", Other: "value must be greater than {{.Value}}"},
+		{ID: "fixed32.gt_lt", Other: "value must be greater than {{.Value}} and less than {{.Value}}"},
+		{ID: "fixed32.gt_lt_exclusive", Other: "value must be greater than {{.Value}} or less than {{.Value}}"},
+		{ID: "fixed32.gt_lte", Other: "value must be greater than {{.Value}} and less than or equal to {{.Value}}"},
+		{ID: "fixed32.gt_lte_exclusive", Other: "value must be greater than {{.Value}} or less than or equal to {{.Value}}"},
+		{ID: "fixed32.gte", Other: "value must be greater than or equal to {{.Value}}"},
+		{ID: "fixed32.gte_lt", Other: "value must be greater than or equal to {{.Value}} and less than {{.Value}}"},
+		{ID: "fixed32.gte_lt_exclusive", Other: "value must be greater than or equal to {{.Value}} or less than {{.Value}}"},
+		{ID: "fixed32.gte_lte", Other: "value must be greater than or equal to {{.Value}} and less than or equal to {{.Value}}"},
+		{ID: "fixed32.gte_lte_exclusive", Other: "value must be greater than or equal to {{.Value}} or less than or equal to {{.Value}}"},
+		{ID: "fixed32.in", Other: "value must be in list {{.Value}}"},
+		{ID: "fixed32.lt", Other: "value must be less than {{.Value}}"},
+		{ID: "fixed32.lte", Other: "value must be less than or equal to {{.Value}}"},
+		{ID: "fixed32.not_in", Other: "value must not be in list {{.Value}}"},
+		{ID: "fixed64.const", Other: "value must equal {{.Value}}"},
+		{ID: "fixed64.gt", Other: "value must be greater than {{.Value}}"},
+		{ID: "fixed64.gt_lt", Other: "value must be greater than {{.Value}} and less than {{.Value}}"},
+		{ID: "fixed64.gt_lt_exclusive", Other: "value must be greater than {{.Value}} or less than {{.Value}}"},
+		{ID: "fixed64.gt_lte", Other: "value must be greater than {{.Value}} and less than or equal to {{.Value}}"},
+		{ID: "fixed64.gt_lte_exclusive", Other: "value must be greater than {{.Value}} or less than or equal to {{.Value}}"},
+		{ID: "fixed64.gte", Other: "value must be greater than or equal to {{.Value}}"},
+		{ID: "fixed64.gte_lt", Other: "value must be greater than or equal to {{.Value}} and less than {{.Value}}"},
+		{ID: "fixed64.gte_lt_exclusive", Other: "value must be greater than or equal to {{.Value}} or less than {{.Value}}"},
+		{ID: "fixed64.gte_lte", Other: "value must be greater than or equal to {{.Value}} and less than or equal to {{.Value}}"},
+		{ID: "fixed64.gte_lte_exclusive", Other: "value must be greater than or equal to {{.Value}} or less than or equal to {{.Value}}"},
+		{ID: "fixed64.in", Other: "value must be in list {{.Value}}"},
+		{ID: "fixed64.lt", Other: "value must be less than {{.Value}}"},
+		{ID: "fixed64.lte", Other: "value must be less than or equal to {{.Value}}"},
+		{ID: "fixed64.not_in", Other: "value must not be in list {{.Value}}"},
+		{ID: "float.const", Other: "value must equal {{.Value}}"},
+		{ID: "float.finite", Other: "value must be finite"},
+		{ID: "float.gt", Other: "value must be greater than {{.Value}}"},
+		{ID: "float.gt_lt", Other: "value must be greater than {{.Value}} and less than {{.Value}}"},
+		{ID: "float.gt_lt_exclusive", Other: "value must be greater than {{.Value}} or less than {{.Value}}"},
+		{ID: "float.gt_lte", Other: "value must be greater than {{.Value}} and less than or equal to {{.Value}}"},
+		{ID: "float.gt_lte_exclusive", Other: "value must be greater than {{.Value}} or less than or equal to {{.Value}}"},
+		{ID: "float.gte", Other: "value must be greater than or equal to {{.Value}}"},
+		{ID: "float.gte_lt", Other: "value must be greater than or equal to {{.Value}} and less than {{.Value}}"},
+		{ID: "float.gte_lt_exclusive", Other: "value must be greater than or equal to {{.Value}} or less than {{.Value}}"},
+		{ID: "float.gte_lte", Other: "value must be greater than or equal to {{.Value}} and less than or equal to {{.Value}}"},
+		{ID: "float.gte_lte_exclusive", Other: "value must be greater than or equal to {{.Value}} or less than or equal to {{.Value}}"},
+		{ID: "float.in", Other: "value must be in list {{.Value}}"},
+		{ID: "float.lt", Other: "value must be less than {{.Value}}"},
+		{ID: "float.lte", Other: "value must be less than or equal to {{.Value}}"},
+		{ID: "float.not_in", Other: "value must not be in list {{.Value}}"},
+		{ID: "int32.const", Other: "value must equal {{.Value}}"},
+		{ID: "int32.gt", Other: "value must be greater than {{.Value}}"},
+		{ID: "int32.gt_lt", Other: "value must be greater than {{.Value}} and less than {{.Value}}"},
+		{ID: "int32.gt_lt_exclusive", Other: "value must be greater than {{.Value}} or less than {{.Value}}"},
+		{ID: "int32.gt_lte", Other: "value must be greater than {{.Value}} and less than or equal to {{.Value}}"},
+		{ID: "int32.gt_lte_exclusive", Other: "value must be greater than {{.Value}} or less than or equal to {{.Value}}"},
+		{ID: "int32.gte", Other: "value must be greater than or equal to {{.Value}}"},
+		{ID: "int32.gte_lt", Other: "value must be greater than or equal to {{.Value}} and less than {{.Value}}"},
+		{ID: "int32.gte_lt_exclusive", Other: "value must be greater than or equal to {{.Value}} or less than {{.Value}}"},
+		{ID: "int32.gte_lte", Other: "value must be greater than or equal to {{.Value}} and less than or equal to {{.Value}}"},
+		{ID: "int32.gte_lte_exclusive", Other: "value must be greater than or equal to {{.Value}} or less than or equal to {{.Value}}"},
+		{ID: "int32.in", Other: "value must be in list {{.Value}}"},
+		{ID: "int32.lt", Other: "value must be less than {{.Value}}"},
+		{ID: "int32.lte", Other: "value must be less than or equal to {{.Value}}"},
+		{ID: "int32.not_in", Other: "value must not be in list {{.Value}}"},
+		{ID: "int64.const", Other: "value must equal {{.Value}}"},
+		{ID: "int64.gt", Other: "value must be greater than {{.Value}}"},
+		{ID: "int64.gt_lt", Other: "value must be greater than {{.Value}} and less than {{.Value}}"},
+		{ID: "int64.gt_lt_exclusive", Other: "value must be greater than {{.Value}} or less than {{.Value}}"},
+		{ID: "int64.gt_lte", Other: "value must be greater than {{.Value}} and less than or equal to {{.Value}}"},
+		{ID: "int64.gt_lte_exclusive", Other: "value must be greater than {{.Value}} or less than or equal to {{.Value}}"},
+		{ID: "int64.gte", Other: "value must be greater than or equal to {{.Value}}"},
+		{ID: "int64.gte_lt", Other: "value must be greater than or equal to {{.Value}} and less than {{.Value}}"},
+		{ID: "int64.gte_lt_exclusive", Other: "value must be greater than or equal to {{.Value}} or less than {{.Value}}"},
+		{ID: "int64.gte_lte", Other: "value must be greater than or equal to {{.Value}} and less than or equal to {{.Value}}"},
+		{ID: "int64.gte_lte_exclusive", Other: "value must be greater than or equal to {{.Value}} or less than or equal to {{.Value}}"},
+		{ID: "int64.in", Other: "value must be in list {{.Value}}"},
+		{ID: "int64.lt", Other: "value must be less than {{.Value}}"},
+		{ID: "int64.lte", Other: "value must be less than or equal to {{.Value}}"},
+		{ID: "int64.not_in", Other: "value must not be in list {{.Value}}"},
+		{ID: "map.max_pairs", Other: "map must be at most {{.Value}} entries"},
+		{ID: "map.min_pairs", Other: "map must be at least {{.Value}} entries"},
+		{ID: "repeated.max_items", Other: "value must contain no more than {{.Value}} item(s)"},
+		{ID: "repeated.min_items", Other: "value must contain at least {{.Value}} item(s)"},
+		{ID: "repeated.unique", Other: "repeated value must contain unique items"},
+		{ID: "sfixed32.const", Other: "value must equal {{.Value}}"},
+		{ID: "sfixed32.gt", Other: "value must be greater than {{.Value}}"},
+		{ID: "sfixed32.gt_lt", Other: "value must be greater than {{.Value}} and less than {{.Value}}"},
+		{ID: "sfixed32.gt_lt_exclusive", Other: "value must be greater than {{.Value}} or less than {{.Value}}"},
+		{ID: "sfixed32.gt_lte", Other: "value must be greater than {{.Value}} and less than or equal to {{.Value}}"},
+		{ID: "sfixed32.gt_lte_exclusive", Other: "value must be greater than {{.Value}} or less than or equal to {{.Value}}"},
+		{ID: "sfixed32.gte", Other: "value must be greater than or equal to {{.Value}}"},
+		{ID: "sfixed32.gte_lt", Other: "value must be greater than or equal to {{.Value}} and less than {{.Value}}"},
+		{ID: "sfixed32.gte_lt_exclusive", Other: "value must be greater than or equal to {{.Value}} or less than {{.Value}}"},
+		{ID: "sfixed32.gte_lte", Other: "value must be greater than or equal to {{.Value}} and less than or equal to {{.Value}}"},
+		{ID: "sfixed32.gte_lte_exclusive", Other: "value must be greater than or equal to {{.Value}} or less than or equal to {{.Value}}"},
+		{ID: "sfixed32.in", Other: "value must be in list {{.Value}}"},
+		{ID: "sfixed32.lt", Other: "value must be less than {{.Value}}"},
+		{ID: "sfixed32.lte", Other: "value must be less than or equal to {{.Value}}"},
+		{ID: "sfixed32.not_in", Other: "value must not be in list {{.Value}}"},
+		{ID: "sfixed64.const", Other: "value must equal {{.Value}}"},
+		{ID: "sfixed64.gt", Other: "value must be greater than {{.Value}}"},
+		{ID: "sfixed64.gt_lt", Other: "value must be greater than {{.Value}} and less than {{.Value}}"},
+		{ID: "sfixed64.gt_lt_exclusive", Other: "value must be greater than {{.Value}} or less than {{.Value}}"},
+		{ID: "sfixed64.gt_lte", Other: "value must be greater than {{.Value}} and less than or equal to {{.Value}}"},
+		{ID: "sfixed64.gt_lte_exclusive", Other: "value must be greater than {{.Value}} or less than or equal to {{.Value}}"},
+		{ID: "sfixed64.gte", Other: "value must be greater than or equal to {{.Value}}"},
+		{ID: "sfixed64.gte_lt", Other: "value must be greater than or equal to {{.Value}} and less than {{.Value}}"},
+		{ID: "sfixed64.gte_lt_exclusive", Other: "value must be greater than or equal to {{.Value}} or less than {{.Value}}"},
+		{ID: "sfixed64.gte_lte", Other: "value must be greater than or equal to {{.Value}} and less than or equal to {{.Value}}"},
+		{ID: "sfixed64.gte_lte_exclusive", Other: "value must be greater than or equal to {{.Value}} or less than or equal to {{.Value}}"},
+		{ID: "sfixed64.in", Other: "value must be in list {{.Value}}"},
+		{ID: "sfixed64.lt", Other: "value must be less than {{.Value}}"},
+		{ID: "sfixed64.lte", Other: "value must be less than or equal to {{.Value}}"},
+		{ID: "sfixed64.not_in", Other: "value must not be in list {{.Value}}"},
+		{ID: "sint32.const", Other: "value must equal {{.Value}}"},
+		{ID: "sint32.gt", Other: "value must be greater than {{.Value}}"},
+		{ID: "sint32.gt_lt", Other: "value must be greater than {{.Value}} and less than {{.Value}}"},
+		{ID: "sint32.gt_lt_exclusive", Other: "value must be greater than {{.Value}} or less than {{.Value}}"},
+		{ID: "sint32.gt_lte", Other: "value must be greater than {{.Value}} and less than or equal to {{.Value}}"},
+		{ID: "sint32.gt_lte_exclusive", Other: "value must be greater than {{.Value}} or less than or equal to {{.Value}}"},
+		{ID: "sint32.gte", Other: "value must be greater than or equal to {{.Value}}"},
+		{ID: "sint32.gte_lt", Other: "value must be greater than or equal to {{.Value}} and less than {{.Value}}"},
+		{ID: "sint32.gte_lt_exclusive", Other: "value must be greater than or equal to {{.Value}} or less than {{.Value}}"},
+		{ID: "sint32.gte_lte", Other: "value must be greater than or equal to {{.Value}} and less than or equal to {{.Value}}"},
+		{ID: "sint32.gte_lte_exclusive", Other: "value must be greater than or equal to {{.Value}} or less than or equal to {{.Value}}"},
+		{ID: "sint32.in", Other: "value must be in list {{.Value}}"},
+		{ID: "sint32.lt", Other: "value must be less than {{.Value}}"},
+		{ID: "sint32.lte", Other: "value must be less than or equal to {{.Value}}"},
+		{ID: "sint32.not_in", Other: "value must not be in list {{.Value}}"},
+		{ID: "sint64.const", Other: "value must equal {{.Value}}"},
+		{ID: "sint64.gt", Other: "value must be greater than {{.Value}}"},
+		{ID: "sint64.gt_lt", Other: "value must be greater than {{.Value}} and less than {{.Value}}"},
+		{ID: "sint64.gt_lt_exclusive", Other: "value must be greater than {{.Value}} or less than {{.Value}}"},
+		{ID: "sint64.gt_lte", Other: "value must be greater than {{.Value}} and less than or equal to {{.Value}}"},
+		{ID: "sint64.gt_lte_exclusive", Other: "value must be greater than {{.Value}} or less than or equal to {{.Value}}"},
+		{ID: "sint64.gte", Other: "value must be greater than or equal to {{.Value}}"},
+		{ID: "sint64.gte_lt", Other: "value must be greater than or equal to {{.Value}} and less than {{.Value}}"},
+		{ID: "sint64.gte_lt_exclusive", Other: "value must be greater than or equal to {{.Value}} or less than {{.Value}}"},
+		{ID: "sint64.gte_lte", Other: "value must be greater than or equal to {{.Value}} and less than or equal to {{.Value}}"},
+		{ID: "sint64.gte_lte_exclusive", Other: "value must be greater than or equal to {{.Value}} or less than or equal to {{.Value}}"},
+		{ID: "sint64.in", Other: "value must be in list {{.Value}}"},
+		{ID: "sint64.lt", Other: "value must be less than {{.Value}}"},
+		{ID: "sint64.lte", Other: "value must be less than or equal to {{.Value}}"},
+		{ID: "sint64.not_in", Other: "value must not be in list {{.Value}}"},
+		{ID: "string.address", Other: "value must be a valid hostname, or ip address"},
+		{ID: "string.address_empty", Other: "value is empty, which is not a valid hostname, or ip address"},
+		{ID: "string.const", Other: "value must equal {{.Value}}"},
+		{ID: "string.contains", Other: "value does not contain substring {{.Value}}"},
+		{ID: "string.email", Other: "value must be a valid email address"},
+		{ID: "string.email_empty", Other: "value is empty, which is not a valid email address"},
+		{ID: "string.host_and_port", Other: "value must be a valid host (hostname or IP address) and port pair"},
+		{ID: "string.host_and_port_empty", Other: "value is empty, which is not a valid host and port pair"},
+		{ID: "string.hostname", Other: "value must be a valid hostname"},
+		{ID: "string.hostname_empty", Other: "value is empty, which is not a valid hostname"},
+		{ID: "string.in", Other: "value must be in list {{.Value}}"},
+		{ID: "string.ip", Other: "value must be a valid IP address"},
+		{ID: "string.ip_empty", Other: "value is empty, which is not a valid IP address"},
+		{ID: "string.ip_prefix", Other: "value must be a valid IP prefix"},
+		{ID: "string.ip_prefix_empty", Other: "value is empty, which is not a valid IP prefix"},
+		{ID: "string.ip_with_prefixlen", Other: "value must be a valid IP prefix"},
+		{ID: "string.ip_with_prefixlen_empty", Other: "value is empty, which is not a valid IP prefix"},
+		{ID: "string.ipv4", Other: "value must be a valid IPv4 address"},
+		{ID: "string.ipv4_empty", Other: "value is empty, which is not a valid IPv4 address"},
+		{ID: "string.ipv4_prefix", Other: "value must be a valid IPv4 prefix"},
+		{ID: "string.ipv4_prefix_empty", Other: "value is empty, which is not a valid IPv4 prefix"},
+		{ID: "string.ipv4_with_prefixlen", Other: "value must be a valid IPv4 address with prefix length"},
+		{ID: "string.ipv4_with_prefixlen_empty", Other: "value is empty, which is not a valid IPv4 address with prefix length"},
+		{ID: "string.ipv6", Other: "value must be a valid IPv6 address"},
+		{ID: "string.ipv6_empty", Other: "value is empty, which is not a valid IPv6 address"},
+		{ID: "string.ipv6_prefix", Other: "value must be a valid IPv6 prefix"},
+		{ID: "string.ipv6_prefix_empty", Other: "value is empty, which is not a valid IPv6 prefix"},
+		{ID: "string.ipv6_with_prefixlen", Other: "value must be a valid IPv6 address with prefix length"},
+		{ID: "string.ipv6_with_prefixlen_empty", Other: "value is empty, which is not a valid IPv6 address with prefix length"},
+		{ID: "string.len", Other: "value length must be {{.Value}} characters"},
+		{ID: "string.len_bytes", Other: "value length must be {{.Value}} bytes"},
+		{ID: "string.max_bytes", Other: "value length must be at most {{.Value}} bytes"},
+		{ID: "string.max_len", Other: "value length must be at most {{.Value}} characters"},
+		{ID: "string.min_bytes", Other: "value length must be at least {{.Value}} bytes"},
+		{ID: "string.min_len", Other: "value length must be at least {{.Value}} characters"},
+		{ID: "string.not_contains", Other: "value contains substring {{.Value}}"},
+		{ID: "string.not_in", Other: "value must not be in list {{.Value}}"},
+		{ID: "string.pattern", Other: "value does not match regex pattern {{.Value}}"},
+		{ID: "string.prefix", Other: "value does not have prefix {{.Value}}"},
+		{ID: "string.suffix", Other: "value does not have suffix {{.Value}}"},
+		{ID: "string.tuuid", Other: "value must be a valid trimmed UUID"},
+		{ID: "string.tuuid_empty", Other: "value is empty, which is not a valid trimmed UUID"},
+		{ID: "string.uri", Other: "value must be a valid URI"},
+		{ID: "string.uri_empty", Other: "value is empty, which is not a valid URI"},
+		{ID: "string.uri_ref", Other: "value must be a valid URI Reference"},
+		{ID: "string.uuid", Other: "value must be a valid UUID"},
+		{ID: "string.uuid_empty", Other: "value is empty, which is not a valid UUID"},
+		{ID: "string.well_known_regex.header_name", Other: "value must be a valid HTTP header name"},
+		{ID: "string.well_known_regex.header_name_empty", Other: "value is empty, which is not a valid HTTP header name"},
+		{ID: "string.well_known_regex.header_value", Other: "value must be a valid HTTP header value"},
+		{ID: "timestamp.const", Other: "value must equal {{.Value}}"},
+		{ID: "timestamp.gt", Other: "value must be greater than {{.Value}}"},
+		{ID: "timestamp.gt_lt", Other: "value must be greater than {{.Value}} and less than {{.Value}}"},
+		{ID: "timestamp.gt_lt_exclusive", Other: "value must be greater than {{.Value}} or less than {{.Value}}"},
+		{ID: "timestamp.gt_lte", Other: "value must be greater than {{.Value}} and less than or equal to {{.Value}}"},
+		{ID: "timestamp.gt_lte_exclusive", Other: "value must be greater than {{.Value}} or less than or equal to {{.Value}}"},
+		{ID: "timestamp.gt_now", Other: "value must be greater than now"},
+		{ID: "timestamp.gte", Other: "value must be greater than or equal to {{.Value}}"},
+		{ID: "timestamp.gte_lt", Other: "value must be greater than or equal to {{.Value}} and less than {{.Value}}"},
+		{ID: "timestamp.gte_lt_exclusive", Other: "value must be greater than or equal to {{.Value}} or less than {{.Value}}"},
+		{ID: "timestamp.gte_lte", Other: "value must be greater than or equal to {{.Value}} and less than or equal to {{.Value}}"},
+		{ID: "timestamp.gte_lte_exclusive", Other: "value must be greater than or equal to {{.Value}} or less than or equal to {{.Value}}"},
+		{ID: "timestamp.lt", Other: "value must be less than {{.Value}}"},
+		{ID: "timestamp.lt_now", Other: "value must be less than now"},
+		{ID: "timestamp.lte", Other: "value must be less than or equal to {{.Value}}"},
+		{ID: "timestamp.within", Other: "value must be within {{.Value}} of now"},
+		{ID: "uint32.const", Other: "value must equal {{.Value}}"},
+		{ID: "uint32.gt", Other: "value must be greater than {{.Value}}"},
+		{ID: "uint32.gt_lt", Other: "value must be greater than {{.Value}} and less than {{.Value}}"},
+		{ID: "uint32.gt_lt_exclusive", Other: "value must be greater than {{.Value}} or less than {{.Value}}"},
+		{ID: "uint32.gt_lte", Other: "value must be greater than {{.Value}} and less than or equal to {{.Value}}"},
+		{ID: "uint32.gt_lte_exclusive", Other: "value must be greater than {{.Value}} or less than or equal to {{.Value}}"},
+		{ID: "uint32.gte", Other: "value must be greater than or equal to {{.Value}}"},
+		{ID: "uint32.gte_lt", Other: "value must be greater than or equal to {{.Value}} and less than {{.Value}}"},
+		{ID: "uint32.gte_lt_exclusive", Other: "value must be greater than or equal to {{.Value}} or less than {{.Value}}"},
+		{ID: "uint32.gte_lte", Other: "value must be greater than or equal to {{.Value}} and less than or equal to {{.Value}}"},
+		{ID: "uint32.gte_lte_exclusive", Other: "value must be greater than or equal to {{.Value}} or less than or equal to {{.Value}}"},
+		{ID: "uint32.in", Other: "value must be in list {{.Value}}"},
+		{ID: "uint32.lt", Other: "value must be less than {{.Value}}"},
+		{ID: "uint32.lte", Other: "value must be less than or equal to {{.Value}}"},
+		{ID: "uint32.not_in", Other: "value must not be in list {{.Value}}"},
+		{ID: "uint64.const", Other: "value must equal {{.Value}}"},
+		{ID: "uint64.gt", Other: "value must be greater than {{.Value}}"},
+		{ID: "uint64.gt_lt", Other: "value must be greater than {{.Value}} and less than {{.Value}}"},
+		{ID: "uint64.gt_lt_exclusive", Other: "value must be greater than {{.Value}} or less than {{.Value}}"},
+		{ID: "uint64.gt_lte", Other: "value must be greater than {{.Value}} and less than or equal to {{.Value}}"},
+		{ID: "uint64.gt_lte_exclusive", Other: "value must be greater than {{.Value}} or less than or equal to {{.Value}}"},
+		{ID: "uint64.gte", Other: "value must be greater than or equal to {{.Value}}"},
+		{ID: "uint64.gte_lt", Other: "value must be greater than or equal to {{.Value}} and less than {{.Value}}"},
+		{ID: "uint64.gte_lt_exclusive", Other: "value must be greater than or equal to {{.Value}} or less than {{.Value}}"},
+		{ID: "uint64.gte_lte", Other: "value must be greater than or equal to {{.Value}} and less than or equal to {{.Value}}"},
+		{ID: "uint64.gte_lte_exclusive", Other: "value must be greater than or equal to {{.Value}} or less than or equal to {{.Value}}"},
+		{ID: "uint64.in", Other: "value must be in list {{.Value}}"},
+		{ID: "uint64.lt", Other: "value must be less than {{.Value}}"},
+		{ID: "uint64.lte", Other: "value must be less than or equal to {{.Value}}"},
+		{ID: "uint64.not_in", Other: "value must not be in list {{.Value}}"},
+	}, map[string][]templatePart{
+		"value must equal {{.Value}}":                                                            {{text: "value must equal "}, {field: "Value"}},
+		"value must be {{.Value}}":                                                               {{text: "value must be "}, {field: "Value"}},
+		"value does not contain {{.Value}}":                                                      {{text: "value does not contain "}, {field: "Value"}},
+		"value must be in list {{.Value}}":                                                       {{text: "value must be in list "}, {field: "Value"}},
+		"value length must be {{.Value}} bytes":                                                  {{text: "value length must be "}, {field: "Value"}, {text: " bytes"}},
+		"value must be at most {{.Value}} bytes":                                                 {{text: "value must be at most "}, {field: "Value"}, {text: " bytes"}},
+		"value length must be at least {{.Value}} bytes":                                         {{text: "value length must be at least "}, {field: "Value"}, {text: " bytes"}},
+		"value must not be in list {{.Value}}":                                                   {{text: "value must not be in list "}, {field: "Value"}},
+		"value must match regex pattern {{.Value}}":                                              {{text: "value must match regex pattern "}, {field: "Value"}},
+		"value does not have prefix {{.Value}}":                                                  {{text: "value does not have prefix "}, {field: "Value"}},
+		"value does not have suffix {{.Value}}":                                                  {{text: "value does not have suffix "}, {field: "Value"}},
+		"value must be greater than {{.Value}}":                                                  {{text: "value must be greater than "}, {field: "Value"}},
+		"value must be greater than {{.Value}} and less than {{.Value}}":                         {{text: "value must be greater than "}, {field: "Value"}, {text: " and less than "}, {field: "Value"}},
+		"value must be greater than {{.Value}} or less than {{.Value}}":                          {{text: "value must be greater than "}, {field: "Value"}, {text: " or less than "}, {field: "Value"}},
+		"value must be greater than {{.Value}} and less than or equal to {{.Value}}":             {{text: "value must be greater than "}, {field: "Value"}, {text: " and less than or equal to "}, {field: "Value"}},
+		"value must be greater than {{.Value}} or less than or equal to {{.Value}}":              {{text: "value must be greater than "}, {field: "Value"}, {text: " or less than or equal to "}, {field: "Value"}},
+		"value must be greater than or equal to {{.Value}}":                                      {{text: "value must be greater than or equal to "}, {field: "Value"}},
+		"value must be greater than or equal to {{.Value}} and less than {{.Value}}":             {{text: "value must be greater than or equal to "}, {field: "Value"}, {text: " and less than "}, {field: "Value"}},
+		"value must be greater than or equal to {{.Value}} or less than {{.Value}}":              {{text: "value must be greater than or equal to "}, {field: "Value"}, {text: " or less than "}, {field: "Value"}},
+		"value must be greater than or equal to {{.Value}} and less than or equal to {{.Value}}": {{text: "value must be greater than or equal to "}, {field: "Value"}, {text: " and less than or equal to "}, {field: "Value"}},
+		"value must be greater than or equal to {{.Value}} or less than or equal to {{.Value}}":  {{text: "value must be greater than or equal to "}, {field: "Value"}, {text: " or less than or equal to "}, {field: "Value"}},
+		"value must be less than {{.Value}}":                                                     {{text: "value must be less than "}, {field: "Value"}},
+		"value must be less than or equal to {{.Value}}":                                         {{text: "value must be less than or equal to "}, {field: "Value"}},
+		"#{{.Index}}":                                         {{text: "#"}, {field: "Index"}},
+		"\"{{.Key}}\"":                                        {{text: "\""}, {field: "Key"}, {text: "\""}},
+		"map must be at most {{.Value}} entries":              {{text: "map must be at most "}, {field: "Value"}, {text: " entries"}},
+		"map must be at least {{.Value}} entries":             {{text: "map must be at least "}, {field: "Value"}, {text: " entries"}},
+		"value must contain no more than {{.Value}} item(s)":  {{text: "value must contain no more than "}, {field: "Value"}, {text: " item(s)"}},
+		"value must contain at least {{.Value}} item(s)":      {{text: "value must contain at least "}, {field: "Value"}, {text: " item(s)"}},
+		"value does not contain substring {{.Value}}":         {{text: "value does not contain substring "}, {field: "Value"}},
+		"value length must be {{.Value}} characters":          {{text: "value length must be "}, {field: "Value"}, {text: " characters"}},
+		"value length must be at most {{.Value}} bytes":       {{text: "value length must be at most "}, {field: "Value"}, {text: " bytes"}},
+		"value length must be at most {{.Value}} characters":  {{text: "value length must be at most "}, {field: "Value"}, {text: " characters"}},
+		"value length must be at least {{.Value}} characters": {{text: "value length must be at least "}, {field: "Value"}, {text: " characters"}},
+		"value contains substring {{.Value}}":                 {{text: "value contains substring "}, {field: "Value"}},
+		"value does not match regex pattern {{.Value}}":       {{text: "value does not match regex pattern "}, {field: "Value"}},
+		"value must be within {{.Value}} of now":              {{text: "value must be within "}, {field: "Value"}, {text: " of now"}},
+	})
+}
@@ -0,0 +1,340 @@
+// Code generated by gencatalog from locales/zh.json. DO NOT EDIT.
+
+//go:build translator_catalog && (!translator_select_locales || translator_locale_zh)
+
+package translator
+
+import "github.com/nicksnyder/go-i18n/v2/i18n"
+
+func init() {
+	registerCatalog("zh", []*i18n.Message{
+		{ID: "bool.const", Other: "值必须等于 {{.Value}}"},
+		{ID: "bytes.const", Other: "值必须是 {{.Value}}"},
+		{ID: "bytes.contains", Other: "值不包含 {{.Value}}"},
+		{ID: "bytes.in", Other: "值必须在列表 {{.Value}} 中"},
+		{ID: "bytes.ip", Other: "值必须是有效的 IP 地址"},
+		{ID: "bytes.ip_empty", Other: "值为空，不是有效的 IP 地址"},
+		{ID: "bytes.ipv4", Other: "值必须是有效的 IPv4 地址"},
+		{ID: "bytes.ipv4_empty", Other: "值为空，不是有效的 IPv4 地址"},
+		{ID: "bytes.ipv6", Other: "值必须是有效的 IPv6 地址"},
+		{ID: "bytes.ipv6_empty", Other: "值为空，不是有效的 IPv6 地址"},
+		{ID: "bytes.len", Other: "值长度必须为 {{.Value}} 字节"},
+		{ID: "bytes.max_len", Other: "值长度必须最多为 {{.Value}} 字节"},
+		{ID: "bytes.min_len", Other: "值长度必须至少为 {{.Value}} 字节"},
+		{ID: "bytes.not_in", Other: "值不能在列表 {{.Value}} 中"},
+		{ID: "bytes.pattern", Other: "值必须匹配正则表达式模式 {{.Value}}"},
+		{ID: "bytes.prefix", Other: "值没有前缀 {{.Value}}"},
+		{ID: "bytes.suffix", Other: "值没有后缀 {{.Value}}"},
+		{ID: "double.const", Other: "值必须等于 {{.Value}}"},
+		{ID: "double.finite", Other: "值必须是有限的"},
+		{ID: "double.gt", Other: "值必须大于 {{.Value}}"},
+		{ID: "double.gt_lt", Other: "值必须大于 {{.Value}} 且小于 {{.Value}}"},
+		{ID: "double.gt_lt_exclusive", Other: "值必须大于 {{.Value}} 或小于 {{.Value}}"},
+		{ID: "double.gt_lte", Other: "值必须大于 {{.Value}} 且小于或等于 {{.Value}}"},
+		{ID: "double.gt_lte_exclusive", Other: "值必须大于 {{.Value}} 或小于或等于 {{.Value}}"},
+		{ID: "double.gte", Other: "值必须大于或等于 {{.Value}}"},
+		{ID: "double.gte_lt", Other: "值必须大于或等于 {{.Value}} 且小于 {{.Value}}"},
+		{ID: "double.gte_lt_exclusive", Other: "值必须大于或等于 {{.Value}} 或小于 {{.Value}}"},
+		{ID: "double.gte_lte", Other: "值必须大于或等于 {{.Value}} 且小于或等于 {{.Value}}"},
+		{ID: "double.gte_lte_exclusive", Other: "值必须大于或等于 {{.Value}} 或小于或等于 {{.Value}}"},
+		{ID: "double.in", Other: "值必须在列表 {{.Value}} 中"},
+		{ID: "double.lt", Other: "值必须小于 {{.Value}}"},
+		{ID: "double.lte", Other: "值必须小于或等于 {{.Value}}"},
+		{ID: "double.not_in", Other: "值不能在列表 {{.Value}} 中"},
+		{ID: "duration.const", Other: "值必须等于 {{.Value}}"},
+		{ID: "duration.gt", Other: "值必须大于 {{.Value}}"},
+		{ID: "duration.gt_lt", Other: "值必须大于 {{.Value}} 且小于 {{.Value}}"},
+		{ID: "duration.gt_lt_exclusive", Other: "值必须大于 {{.Value}} 或小于 {{.Value}}"},
+		{ID: "duration.gt_lte", Other: "值必须大于 {{.Value}} 且小于或等于 {{.Value}}"},
+		{ID: "duration.gt_lte_exclusive", Other: "值必须大于 {{.Value}} 或小于或等于 {{.Value}}"},
+		{ID: "duration.gte", Other: "值必须大于或等于 {{.Value}}"},
+		{ID: "duration.gte_lt", Other: "值必须大于或等于 {{.Value}} 且小于 {{.Value}}"},
+		{ID: "duration.gte_lt_exclusive", Other: "值必须大于 {{.Value}} 或小于 {{.Value}}"},
+		{ID: "duration.gte_lte", Other: "值必须大于或等于 {{.Value}} 且小于或等于 {{.Value}}"},
+		{ID: "duration.gte_lte_exclusive", Other: "值必须大于 {{.Value}} 或小于或等于 {{.Value}}"},
+		{ID: "duration.in", Other: "值必须在列表 {{.Value}} 中"},
+		{ID: "duration.lt", Other: "值必须小于 {{.Value}}"},
+		{ID: "duration.lte", Other: "值必须小于或等于 {{.Value}}"},
+		{ID: "duration.not_in", Other: "值不能在列表 {{.Value}} 中"},
+		{ID: "enum.const", Other: "值必须等于 {{.Value}}"},
+		{ID: "enum.in", Other: "值必须在列表 {{.Value}} 中"},
+		{ID: "enum.not_in", Other: "值不能在列表 {{.Value}} 中"},
+		{ID: "field_path.index", Other: "第 {{.Index}} 项"},
+		{ID: "field_path.key", Other: "“{{.Key}}”"},
+		{ID: "field_path.separator", Other: " › "},
+		{ID: "fixed32.const", Other: "值必须等于 {{.Value}}"},
+		{ID: "fixed32.gt", Other: "值必须大于 {{.Value}}"},
+		{ID: "fixed32.gt_lt", Other: "值必须大于 {{.Value}} 且小于 {{.Value}}"},
+		{ID: "fixed32.gt_lt_exclusive", Other: "值必须大于 {{.Value}} 或小于 {{.Value}}"},
+		{ID: "fixed32.gt_lte", Other: "值必须大于 {{.Value}} 且小于或等于 {{.Value}}"},
+		{ID: "fixed32.gt_lte_exclusive", Other: "值必须大于 {{.Value}} 或小于或等于 {{.Value}}"},
+		{ID: "fixed32.gte", Other: "值必须大于或等于 {{.Value}}"},
+		{ID: "fixed32.gte_lt", Other: "值必须大于 {{.Value}} 且小于 {{.Value}}"},
+		{ID: "fixed32.gte_lt_exclusive", Other: "值必须大于 {{.Value}} 或小于 {{.Value}}"},
+		{ID: "fixed32.gte_lte", Other: "值必须大于 {{.Value}} 且小于或等于 {{.Value}}"},
+		{ID: "fixed32.gte_lte_exclusive", Other: "值必须大于 {{.Value}} 或小于或等于 {{.Value}}"},
+		{ID: "fixed32.in", Other: "值必须在列表 {{.Value}} 中"},
+		{ID: "fixed32.lt", Other: "值必须小于 {{.Value}}"},
+		{ID: "fixed32.lte", Other: "值必须小于或等于 {{.Value}}"},
+		{ID: "fixed32.not_in", Other: "值不能在列表 {{.Value}} 中"},
+		{ID: "fixed64.const", Other: "值必须等于 {{.Value}}"},
+		{ID: "fixed64.gt", Other: "值必须大于 {{.Value}}"},
+		{ID: "fixed64.gt_lt", Other: "值必须大于 {{.Value}} 且小于 {{.Value}}"},
+		{ID: "fixed64.gt_lt_exclusive", Other: "值必须大于 {{.Value}} 或小于 {{.Value}}"},
+		{ID: "fixed64.gt_lte", Other: "值必须大于 {{.Value}} 且小于或等于 {{.Value}}"},
+		{ID: "fixed64.gt_lte_exclusive", Other: "值必须大于 {{.Value}} 或小于或等于 {{.Value}}"},
+		{ID: "fixed64.gte", Other: "值必须大于或等于 {{.Value}}"},
+		{ID: "fixed64.gte_lt", Other: "值必须大于 {{.Value}} 且小于 {{.Value}}"},
+		{ID: "fixed64.gte_lt_exclusive", Other: "值必须大于 {{.Value}} 或小于 {{.Value}}"},
+		{ID: "fixed64.gte_lte", Other: "值必须大于 {{.Value}} 且小于或等于 {{.Value}}"},
+		{ID: "fixed64.gte_lte_exclusive", Other: "值必须大于 {{.Value}} 或小于或等于 {{.Value}}"},
+		{ID: "fixed64.in", Other: "值必须在列表 {{.Value}} 中"},
+		{ID: "fixed64.lt", Other: "值必须小于 {{.Value}}"},
+		{ID: "fixed64.lte", Other: "值必须小于或等于 {{.Value}}"},
+		{ID: "fixed64.not_in", Other: "值不能在列表 {{.Value}} 中"},
+		{ID: "float.const", Other: "值必须等于 {{.Value}}"},
+		{ID: "float.finite", Other: "值必须是有限的"},
+		{ID: "float.gt", Other: "值必须大于 {{.Value}}"},
+		{ID: "float.gt_lt", Other: "值必须大于 {{.Value}} 且小于 {{.Value}}"},
+		{ID: "float.gt_lt_exclusive", Other: "值必须大于 {{.Value}} 或小于 {{.Value}}"},
+		{ID: "float.gt_lte", Other: "值必须大于 {{.Value}} 且小于或等于 {{.Value}}"},
+		{ID: "float.gt_lte_exclusive", Other: "值必须大于 {{.Value}} 或小于或等于 {{.Value}}"},
+		{ID: "float.gte", Other: "值必须大于或等于 {{.Value}}"},
+		{ID: "float.gte_lt", Other: "值必须大于或等于 {{.Value}} 且小于 {{.Value}}"},
+		{ID: "float.gte_lt_exclusive", Other: "值必须大于或等于 {{.Value}} 或小于 {{.Value}}"},
+		{ID: "float.gte_lte", Other: "值必须大于或等于 {{.Value}} 且小于或等于 {{.Value}}"},
+		{ID: "float.gte_lte_exclusive", Other: "值必须大于或等于 {{.Value}} 或小于或等于 {{.Value}}"},
+		{ID: "float.in", Other: "值必须在列表 {{.Value}} 中"},
+		{ID: "float.lt", Other: "值必须小于 {{.Value}}"},
+		{ID: "float.lte", Other: "值必须小于或等于 {{.Value}}"},
+		{ID: "float.not_in", Other: "值不能在列表 {{.Value}} 中"},
+		{ID: "int32.const", Other: "值必须等于 {{.Value}}"},
+		{ID: "int32.gt", Other: "值必须大于 {{.Value}}"},
+		{ID: "int32.gt_lt", Other: "值必须大于 {{.Value}} 且小于 {{.Value}}"},
+		{ID: "int32.gt_lt_exclusive", Other: "值必须大于 {{.Value}} 或小于 {{.Value}}"},
+		{ID: "int32.gt_lte", Other: "值必须大于 {{.Value}} 且小于或等于 {{.Value}}"},
+		{ID: "int32.gt_lte_exclusive", Other: "值必须大于 {{.Value}} 或小于或等于 {{.Value}}"},
+		{ID: "int32.gte", Other: "值必须大于或等于 {{.Value}}"},
+		{ID: "int32.gte_lt", Other: "值必须大于或等于 {{.Value}} 且小于 {{.Value}}"},
+		{ID: "int32.gte_lt_exclusive", Other: "值必须大于或等于 {{.Value}} 或小于 {{.Value}}"},
+		{ID: "int32.gte_lte", Other: "值必须大于或等于 {{.Value}} 且小于或等于 {{.Value}}"},
+		{ID: "int32.gte_lte_exclusive", Other: "值必须大于或等于 {{.Value}} 或小于或等于 {{.Value}}"},
+		{ID: "int32.in", Other: "值必须在列表 {{.Value}} 中"},
+		{ID: "int32.lt", Other: "值必须小于 {{.Value}}"},
+		{ID: "int32.lte", Other: "值必须小于或等于 {{.Value}}"},
+		{ID: "int32.not_in", Other: "值不能在列表 {{.Value}} 中"},
+		{ID: "int64.const", Other: "值必须等于 {{.Value}}"},
+		{ID: "int64.gt", Other: "值必须大于 {{.Value}}"},
+		{ID: "int64.gt_lt", Other: "值必须大于 {{.Value}} 且小于 {{.Value}}"},
+		{ID: "int64.gt_lt_exclusive", Other: "值必须大于 {{.Value}} 或小于 {{.Value}}"},
+		{ID: "int64.gt_lte", Other: "值必须大于 {{.Value}} 且小于或等于 {{.Value}}"},
+		{ID: "int64.gt_lte_exclusive", Other: "值必须大于 {{.Value}} 或小于或等于 {{.Value}}"},
+		{ID: "int64.gte", Other: "值必须大于或等于 {{.Value}}"},
+		{ID: "int64.gte_lt", Other: "值必须大于或等于 {{.Value}} 且小于 {{.Value}}"},
+		{ID: "int64.gte_lt_exclusive", Other: "值必须大于 {{.Value}} 或小于 {{.Value}}"},
+		{ID: "int64.gte_lte", Other: "值必须大于或等于 {{.Value}} 且小于或等于 {{.Value}}"},
+		{ID: "int64.gte_lte_exclusive", Other: "值必须大于 {{.Value}} 或小于 {{.Value}}"},
+		{ID: "int64.in", Other: "值必须在列表 {{.Value}} 中"},
+		{ID: "int64.lt", Other: "值必须小于 {{.Value}}"},
+		{ID: "int64.lte", Other: "值必须小于或等于 {{.Value}}"},
+		{ID: "int64.not_in", Other: "值不能在列表 {{.Value}} 中"},
+		{ID: "map.max_pairs", Other: "映射最多只能包含 {{.Value}} 个条目"},
+		{ID: "map.min_pairs", Other: "映射必须至少包含 {{.Value}} 个条目"},
+		{ID: "repeated.max_items", Other: "值必须最多包含 {{.Value}} 个项目"},
+		{ID: "repeated.min_items", Other: "值必须至少包含 {{.Value}} 个项目"},
+		{ID: "repeated.unique", Other: "重复的值必须包含唯一的项目"},
+		{ID: "sfixed32.const", Other: "值必须等于 {{.Value}}"},
+		{ID: "sfixed32.gt", Other: "值必须大于 {{.Value}}"},
+		{ID: "sfixed32.gt_lt", Other: "值必须大于 {{.Value}} 且小于 {{.Value}}"},
+		{ID: "sfixed32.gt_lt_exclusive", Other: "值必须大于 {{.Value}} 或小于 {{.Value}}"},
+		{ID: "sfixed32.gt_lte", Other: "值必须大于 {{.Value}} 且小于或等于 {{.Value}}"},
+		{ID: "sfixed32.gt_lte_exclusive", Other: "值必须大于 {{.Value}} 或小于或等于 {{.Value}}"},
+		{ID: "sfixed32.gte", Other: "值必须大于或等于 {{.Value}}"},
+		{ID: "sfixed32.gte_lt", Other: "值必须大于 {{.Value}} 且小于 {{.Value}}"},
+		{ID: "sfixed32.gte_lt_exclusive", Other: "值必须大于 {{.Value}} 或小于 {{.Value}}"},
+		{ID: "sfixed32.gte_lte", Other: "值必须大于 {{.Value}} 且小于或等于 {{.Value}}"},
+		{ID: "sfixed32.gte_lte_exclusive", Other: "值必须大于 {{.Value}} 或小于或等于 {{.Value}}"},
+		{ID: "sfixed32.in", Other: "值必须在列表 {{.Value}} 中"},
+		{ID: "sfixed32.lt", Other: "值必须小于 {{.Value}}"},
+		{ID: "sfixed32.lte", Other: "值必须小于或等于 {{.Value}}"},
+		{ID: "sfixed32.not_in", Other: "值不能在列表 {{.Value}} 中"},
+		{ID: "sfixed64.const", Other: "值必须等于 {{.Value}}"},
+		{ID: "sfixed64.gt", Other: "值必须大于 {{.Value}}"},
+		{ID: "sfixed64.gt_lt", Other: "值必须大于 {{.Value}} 且小于 {{.Value}}"},
+		{ID: "sfixed64.gt_lt_exclusive", Other: "值必须大于 {{.Value}} 或小于 {{.Value}}"},
+		{ID: "sfixed64.gt_lte", Other: "值必须大于 {{.Value}} 且小于或等于 {{.Value}}"},
+		{ID: "sfixed64.gt_lte_exclusive", Other: "值必须大于 {{.Value}} 或小于或等于 {{.Value}}"},
+		{ID: "sfixed64.gte", Other: "值必须大于或等于 {{.Value}}"},
+		{ID: "sfixed64.gte_lt", Other: "值必须大于 {{.Value}} 且小于 {{.Value}}"},
+		{ID: "sfixed64.gte_lt_exclusive", Other: "值必须大于 {{.Value}} 或小于 {{.Value}}"},
+		{ID: "sfixed64.gte_lte", Other: "值必须大于 {{.Value}} 且小于或等于 {{.Value}}"},
+		{ID: "sfixed64.gte_lte_exclusive", Other: "值必须大于 {{.Value}} 或小于或等于 {{.Value}}"},
+		{ID: "sfixed64.in", Other: "值必须在列表 {{.Value}} 中"},
+		{ID: "sfixed64.lt", Other: "值必须小于 {{.Value}}"},
+		{ID: "sfixed64.lte", Other: "值必须小于或等于 {{.Value}}"},
+		{ID: "sfixed64.not_in", Other: "值不能在列表 {{.Value}} 中"},
+		{ID: "sint32.const", Other: "值必须等于 {{.Value}}"},
+		{ID: "sint32.gt", Other: "值必须大于 {{.Value}}"},
+		{ID: "sint32.gt_lt", Other: "值必须大于 {{.Value}} 且小于 {{.Value}}"},
+		{ID: "sint32.gt_lt_exclusive", Other: "值必须大于 {{.Value}} 或小于 {{.Value}}"},
+		{ID: "sint32.gt_lte", Other: "值必须大于 {{.Value}} 且小于或等于 {{.Value}}"},
+		{ID: "sint32.gt_lte_exclusive", Other: "值必须大于 {{.Value}} 或小于或等于 {{.Value}}"},
+		{ID: "sint32.gte", Other: "值必须大于或等于 {{.Value}}"},
+		{ID: "sint32.gte_lt", Other: "值必须大于或等于 {{.Value}} 且小于 {{.Value}}"},
+		{ID: "sint32.gte_lt_exclusive", Other: "值必须大于 {{.Value}} 或小于 {{.Value}}"},
+		{ID: "sint32.gte_lte", Other: "值必须大于 {{.Value}} 且小于或等于 {{.Value}}"},
+		{ID: "sint32.gte_lte_exclusive", Other: "值必须大于 {{.Value}} 或小于或等于 {{.Value}}"},
+		{ID: "sint32.in", Other: "值必须在列表 {{.Value}} 中"},
+		{ID: "sint32.lt", Other: "值必须小于 {{.Value}}"},
+		{ID: "sint32.lte", Other: "值必须小于或等于 {{.Value}}"},
+		{ID: "sint32.not_in", Other: "值不能在列表 {{.Value}} 中"},
+		{ID: "sint64.const", Other: "值必须等于 {{.Value}}"},
+		{ID: "sint64.gt", Other: "值必须大于 {{.Value}}"},
+		{ID: "sint64.gt_lt", Other: "值必须大于 {{.Value}} 且小于 {{.Value}}"},
+		{ID: "sint64.gt_lt_exclusive", Other: "值必须大于 {{.Value}} 或小于 {{.Value}}"},
+		{ID: "sint64.gt_lte", Other: "值必须大于 {{.Value}} 且小于或等于 {{.Value}}"},
+		{ID: "sint64.gt_lte_exclusive", Other: "值必须大于 {{.Value}} 或小于或等于 {{.Value}}"},
+		{ID: "sint64.gte", Other: "值必须大于或等于 {{.Value}}"},
+		{ID: "sint64.gte_lt", Other: "值必须大于 {{.Value}} 且小于 {{.Value}}"},
+		{ID: "sint64.gte_lt_exclusive", Other: "值必须大于 {{.Value}} 或小于 {{.Value}}"},
+		{ID: "sint64.gte_lte", Other: "值必须大于 {{.Value}} 且小于或等于 {{.Value}}"},
+		{ID: "sint64.gte_lte_exclusive", Other: "值必须大于 {{.Value}} 或小于或等于 {{.Value}}"},
+		{ID: "sint64.in", Other: "值必须在列表 {{.Value}} 中"},
+		{ID: "sint64.lt", Other: "值必须小于 {{.Value}}"},
+		{ID: "sint64.lte", Other: "值必须小于或等于 {{.Value}}"},
+		{ID: "sint64.not_in", Other: "值不能在列表 {{.Value}} 中"},
+		{ID: "string.address", Other: "值必须是有效的主机名或 IP 地址"},
+		{ID: "string.address_empty", Other: "值为空，不是有效的主机名或 IP 地址"},
+		{ID: "string.const", Other: "值必须等于 {{.Value}}"},
+		{ID: "string.contains", Other: "值不包含子字符串 {{.Value}}"},
+		{ID: "string.email", Other: "值必须是有效的电子邮件地址"},
+		{ID: "string.email_empty", Other: "值为空，不是有效的电子邮件地址"},
+		{ID: "string.host_and_port", Other: "值必须是有效的主机（主机名或 IP 地址）和端口对"},
+		{ID: "string.host_and_port_empty", Other: "值为空，不是有效的主机和端口对"},
+		{ID: "string.hostname", Other: "值必须是有效的主机名"},
+		{ID: "string.hostname_empty", Other: "值为空，不是有效的主机名"},
+		{ID: "string.in", Other: "值必须在列表 {{.Value}} 中"},
+		{ID: "string.ip", Other: "值必须是有效的 IP 地址"},
+		{ID: "string.ip_empty", Other: "值为空，不是有效的 IP 地址"},
+		{ID: "string.ip_prefix", Other: "值必须是有效的 IP 前缀"},
+		{ID: "string.ip_prefix_empty", Other: "值为空，不是有效的 IP 前缀"},
+		{ID: "string.ip_with_prefixlen", Other: "值必须是有效的 IP 前缀"},
+		{ID: "string.ip_with_prefixlen_empty", Other: "值为空，不是有效的 IP 前缀"},
+		{ID: "string.ipv4", Other: "值必须是有效的 IPv4 地址"},
+		{ID: "string.ipv4_empty", Other: "值为空，不是有效的 IPv4 地址"},
+		{ID: "string.ipv4_prefix", Other: "值必须是有效的 IPv4 前缀"},
+		{ID: "string.ipv4_prefix_empty", Other: "值为空，不是有效的 IPv4 前缀"},
+		{ID: "string.ipv4_with_prefixlen", Other: "值必须是有效的 IPv4 地址带前缀长度"},
+		{ID: "string.ipv4_with_prefixlen_empty", Other: "值为空，不是有效的 IPv4 地址带前缀长度"},
+		{ID: "string.ipv6", Other: "值必须是有效的 IPv6 地址"},
+		{ID: "string.ipv6_empty", Other: "值为空，不是有效的 IPv6 地址"},
+		{ID: "string.ipv6_prefix", Other: "值必须是有效的 IPv6 前缀"},
+		{ID: "string.ipv6_prefix_empty", Other: "值为空，不是有效的 IPv6 前缀"},
+		{ID: "string.ipv6_with_prefixlen", Other: "值必须是有效的 IPv6 地址带前缀长度"},
+		{ID: "string.ipv6_with_prefixlen_empty", Other: "值为空，不是有效的 IPv6 地址带前缀长度"},
+		{ID: "string.len", Other: "值长度必须为 {{.Value}} 个字符"},
+		{ID: "string.len_bytes", Other: "值长度必须为 {{.Value}} 字节"},
+		{ID: "string.max_bytes", Other: "值长度必须最多为 {{.Value}} 字节"},
+		{ID: "string.max_len", Other: "值长度必须最多为 {{.Value}} 个字符"},
+		{ID: "string.min_bytes", Other: "值长度必须至少为 {{.Value}} 字节"},
+		{ID: "string.min_len", Other: "值长度必须至少为 {{.Value}} 个字符"},
+		{ID: "string.not_contains", Other: "值包含子字符串 {{.Value}}"},
+		{ID: "string.not_in", Other: "值不能在列表 {{.Value}} 中"},
+		{ID: "string.pattern", Other: "值不符合正则表达式模式 {{.Value}}"},
+		{ID: "string.prefix", Other: "值没有前缀 {{.Value}}"},
+		{ID: "string.suffix", Other: "值没有后缀 {{.Value}}"},
+		{ID: "string.tuuid", Other: "值必须是有效的裁剪 UUID"},
+		{ID: "string.tuuid_empty", Other: "值为空，不是有效的裁剪 UUID"},
+		{ID: "string.uri", Other: "值必须是有效的 URI"},
+		{ID: "string.uri_empty", Other: "值为空，不是有效的 URI"},
+		{ID: "string.uri_ref", Other: "值必须是有效的 URI 引用"},
+		{ID: "string.uuid", Other: "值必须是有效的 UUID"},
+		{ID: "string.uuid_empty", Other: "值为空，不是有效的 UUID"},
+		{ID: "string.well_known_regex.header_name", Other: "值必须是有效的 HTTP 头部名称"},
+		{ID: "string.well_known_regex.header_name_empty", Other: "值为空，不是有效的 HTTP 头部名称"},
+		{ID: "string.well_known_regex.header_value", Other: "值必须是有效的 HTTP 头部值"},
+		{ID: "timestamp.const", Other: "值必须等于 {{.Value}}"},
+		{ID: "timestamp.gt", Other: "值必须大于 {{.Value}}"},
+		{ID: "timestamp.gt_lt", Other: "值必须大于 {{.Value}} 且小于 {{.Value}}"},
+		{ID: "timestamp.gt_lt_exclusive", Other: "值必须大于 {{.Value}} 或小于 {{.Value}}"},
+		{ID: "timestamp.gt_lte", Other: "值必须大于 {{.Value}} 且小于或等于 {{.Value}}"},
+		{ID: "timestamp.gt_lte_exclusive", Other: "值必须大于 {{.Value}} 或小于或等于 {{.Value}}"},
+		{ID: "timestamp.gt_now", Other: "值必须大于现在"},
+		{ID: "timestamp.gte", Other: "值必须大于或等于 {{.Value}}"},
+		{ID: "timestamp.gte_lt", Other: "值必须大于或等于 {{.Value}} 且小于 {{.Value}}"},
+		{ID: "timestamp.gte_lt_exclusive", Other: "值必须大于 {{.Value}} 或小于 {{.Value}}"},
+		{ID: "timestamp.gte_lte", Other: "值必须大于或等于 {{.Value}} 且小于或等于 {{.Value}}"},
+		{ID: "timestamp.gte_lte_exclusive", Other: "值必须大于 {{.Value}} 或小于或等于 {{.Value}}"},
+		{ID: "timestamp.lt", Other: "值必须小于 {{.Value}}"},
+		{ID: "timestamp.lt_now", Other: "值必须小于现在"},
+		{ID: "timestamp.lte", Other: "值必须小于或等于 {{.Value}}"},
+		{ID: "timestamp.within", Other: "值必须在现在的 {{.Value}} 范围内"},
+		{ID: "uint32.const", Other: "值必须等于 {{.Value}}"},
+		{ID: "uint32.gt", Other: "值必须大于 {{.Value}}"},
+		{ID: "uint32.gt_lt", Other: "值必须大于 {{.Value}} 且小于 {{.Value}}"},
+		{ID: "uint32.gt_lt_exclusive", Other: "值必须大于 {{.Value}} 或小于 {{.Value}}"},
+		{ID: "uint32.gt_lte", Other: "值必须大于 {{.Value}} 且小于或等于 {{.Value}}"},
+		{ID: "uint32.gt_lte_exclusive", Other: "值必须大于 {{.Value}} 或小于或等于 {{.Value}}"},
+		{ID: "uint32.gte", Other: "值必须大于或等于 {{.Value}}"},
+		{ID: "uint32.gte_lt", Other: "值必须大于或等于 {{.Value}} 且小于 {{.Value}}"},
+		{ID: "uint32.gte_lt_exclusive", Other: "值必须大于 {{.Value}} 或小于 {{.Value}}"},
+		{ID: "uint32.gte_lte", Other: "值必须大于 {{.Value}} 且小于或等于 {{.Value}}"},
+		{ID: "uint32.gte_lte_exclusive", Other: "值必须大于 {{.Value}} 或小于或等于 {{.Value}}"},
+		{ID: "uint32.in", Other: "值必须在列表 {{.Value}} 中"},
+		{ID: "uint32.lt", Other: "值必须小于 {{.Value}}"},
+		{ID: "uint32.lte", Other: "值必须小于或等于 {{.Value}}"},
+		{ID: "uint32.not_in", Other: "值不能在列表 {{.Value}} 中"},
+		{ID: "uint64.const", Other: "值必须等于 {{.Value}}"},
+		{ID: "uint64.gt", Other: "值必须大于 {{.Value}}"},
+		{ID: "uint64.gt_lt", Other: "值必须大于 {{.Value}} 且小于 {{.Value}}"},
+		{ID: "uint64.gt_lt_exclusive", Other: "值必须大于 {{.Value}} 或小于 {{.Value}}"},
+		{ID: "uint64.gt_lte", Other: "值必须大于 {{.Value}} 且小于或等于 {{.Value}}"},
+		{ID: "uint64.gt_lte_exclusive", Other: "值必须大于 {{.Value}} 或小于或等于 {{.Value}}"},
+		{ID: "uint64.gte", Other: "值必须大于或等于 {{.Value}}"},
+		{ID: "uint64.gte_lt", Other: "值必须大于 {{.Value}} 且小于 {{.Value}}"},
+		{ID: "uint64.gte_lt_exclusive", Other: "值必须大于 {{.Value}} 或小于 {{.Value}}"},
+		{ID: "uint64.gte_lte", Other: "值必须大于 {{.Value}} 且小于或等于 {{.Value}}"},
+		{ID: "uint64.gte_lte_exclusive", Other: "值必须大于 {{.Value}} 或小于或等于 {{.Value}}"},
+		{ID: "uint64.in", Other: "值必须在列表 {{.Value}} 中"},
+		{ID: "uint64.lt", Other: "值必须小于 {{.Value}}"},
+		{ID: "uint64.lte", Other: "值必须小于或等于 {{.Value}}"},
+		{ID: "uint64.not_in", Other: "值不能在列表 {{.Value}} 中"},
+	}, map[string][]templatePart{
+		"值必须等于 {{.Value}}":                      {{text: "值必须等于 "}, {field: "Value"}},
+		"值必须是 {{.Value}}":                       {{text: "值必须是 "}, {field: "Value"}},
+		"值不包含 {{.Value}}":                       {{text: "值不包含 "}, {field: "Value"}},
+		"值必须在列表 {{.Value}} 中":                   {{text: "值必须在列表 "}, {field: "Value"}, {text: " 中"}},
+		"值长度必须为 {{.Value}} 字节":                  {{text: "值长度必须为 "}, {field: "Value"}, {text: " 字节"}},
+		"值长度必须最多为 {{.Value}} 字节":                {{text: "值长度必须最多为 "}, {field: "Value"}, {text: " 字节"}},
+		"值长度必须至少为 {{.Value}} 字节":                {{text: "值长度必须至少为 "}, {field: "Value"}, {text: " 字节"}},
+		"值不能在列表 {{.Value}} 中":                   {{text: "值不能在列表 "}, {field: "Value"}, {text: " 中"}},
+		"值必须匹配正则表达式模式 {{.Value}}":               {{text: "值必须匹配正则表达式模式 "}, {field: "Value"}},
+		"值没有前缀 {{.Value}}":                      {{text: "值没有前缀 "}, {field: "Value"}},
+		"值没有后缀 {{.Value}}":                      {{text: "值没有后缀 "}, {field: "Value"}},
+		"值必须大于 {{.Value}}":                      {{text: "值必须大于 "}, {field: "Value"}},
+		"值必须大于 {{.Value}} 且小于 {{.Value}}":       {{text: "值必须大于 "}, {field: "Value"}, {text: " 且小于 "}, {field: "Value"}},
+		"值必须大于 {{.Value}} 或小于 {{.Value}}":       {{text: "值必须大于 "}, {field: "Value"}, {text: " 或小于 "}, {field: "Value"}},
+		"值必须大于 {{.Value}} 且小于或等于 {{.Value}}":    {{text: "值必须大于 "}, {field: "Value"}, {text: " 且小于或等于 "}, {field: "Value"}},
+		"值必须大于 {{.Value}} 或小于或等于 {{.Value}}":    {{text: "值必须大于 "}, {field: "Value"}, {text: " 或小于或等于 "}, {field: "Value"}},
+		"值必须大于或等于 {{.Value}}":                   {{text: "值必须大于或等于 "}, {field: "Value"}},
+		"值必须大于或等于 {{.Value}} 且小于 {{.Value}}":    {{text: "值必须大于或等于 "}, {field: "Value"}, {text: " 且小于 "}, {field: "Value"}},
+		"值必须大于或等于 {{.Value}} 或小于 {{.Value}}":    {{text: "值必须大于或等于 "}, {field: "Value"}, {text: " 或小于 "}, {field: "Value"}},
+		"值必须大于或等于 {{.Value}} 且小于或等于 {{.Value}}": {{text: "值必须大于或等于 "}, {field: "Value"}, {text: " 且小于或等于 "}, {field: "Value"}},
+		"值必须大于或等于 {{.Value}} 或小于或等于 {{.Value}}": {{text: "值必须大于或等于 "}, {field: "Value"}, {text: " 或小于或等于 "}, {field: "Value"}},
+		"值必须小于 {{.Value}}":                      {{text: "值必须小于 "}, {field: "Value"}},
+		"值必须小于或等于 {{.Value}}":                   {{text: "值必须小于或等于 "}, {field: "Value"}},
+		"第 {{.Index}} 项":                        {{text: "第 "}, {field: "Index"}, {text: " 项"}},
+		"“{{.Key}}”":                            {{text: "“"}, {field: "Key"}, {text: "”"}},
+		"映射最多只能包含 {{.Value}} 个条目":               {{text: "映射最多只能包含 "}, {field: "Value"}, {text: " 个条目"}},
+		"映射必须至少包含 {{.Value}} 个条目":               {{text: "映射必须至少包含 "}, {field: "Value"}, {text: " 个条目"}},
+		"值必须最多包含 {{.Value}} 个项目":                {{text: "值必须最多包含 "}, {field: "Value"}, {text: " 个项目"}},
+		"值必须至少包含 {{.Value}} 个项目":                {{text: "值必须至少包含 "}, {field: "Value"}, {text: " 个项目"}},
+		"值不包含子字符串 {{.Value}}":                   {{text: "值不包含子字符串 "}, {field: "Value"}},
+		"值长度必须为 {{.Value}} 个字符":                 {{text: "值长度必须为 "}, {field: "Value"}, {text: " 个字符"}},
+		"值长度必须最多为 {{.Value}} 个字符":               {{text: "值长度必须最多为 "}, {field: "Value"}, {text: " 个字符"}},
+		"值长度必须至少为 {{.Value}} 个字符":               {{text: "值长度必须至少为 "}, {field: "Value"}, {text: " 个字符"}},
+		"值包含子字符串 {{.Value}}":                    {{text: "值包含子字符串 "}, {field: "Value"}},
+		"值不符合正则表达式模式 {{.Value}}":                {{text: "值不符合正则表达式模式 "}, {field: "Value"}},
+		"值必须在现在的 {{.Value}} 范围内":                {{text: "值必须在现在的 "}, {field: "Value"}, {text: " 范围内"}},
+	})
+}
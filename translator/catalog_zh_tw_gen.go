@@ -0,0 +1,340 @@
+// Code generated by gencatalog from locales/zh-TW.json. DO NOT EDIT.
+
+//go:build translator_catalog && (!translator_select_locales || translator_locale_zh_tw)
+
+package translator
+
+import "github.com/nicksnyder/go-i18n/v2/i18n"
+
+func init() {
+	registerCatalog("zh-TW", []*i18n.Message{
+		{ID: "bool.const", Other: "值必須等於 {{.Value}}"},
+		{ID: "bytes.const", Other: "值必須是 {{.Value}}"},
+		{ID: "bytes.contains", Other: "值不包含 {{.Value}}"},
+		{ID: "bytes.in", Other: "值必須在列表 {{.Value}} 中"},
+		{ID: "bytes.ip", Other: "值必須是有效的 IP 地址"},
+		{ID: "bytes.ip_empty", Other: "值為空，不是有效的 IP 地址"},
+		{ID: "bytes.ipv4", Other: "值必須是有效的 IPv4 地址"},
+		{ID: "bytes.ipv4_empty", Other: "值為空，不是有效的 IPv4 地址"},
+		{ID: "bytes.ipv6", Other: "值必須是有效的 IPv6 地址"},
+		{ID: "bytes.ipv6_empty", Other: "值為空，不是有效的 IPv6 地址"},
+		{ID: "bytes.len", Other: "值長度必須為 {{.Value}} 字節"},
+		{ID: "bytes.max_len", Other: "值長度必須最多為 {{.Value}} 字節"},
+		{ID: "bytes.min_len", Other: "值長度必須至少為 {{.Value}} 字節"},
+		{ID: "bytes.not_in", Other: "值不能在列表 {{.Value}} 中"},
+		{ID: "bytes.pattern", Other: "值必須匹配正則表達式模式 {{.Value}}"},
+		{ID: "bytes.prefix", Other: "值没有前綴 {{.Value}}"},
+		{ID: "bytes.suffix", Other: "值没有后綴 {{.Value}}"},
+		{ID: "double.const", Other: "值必須等於 {{.Value}}"},
+		{ID: "double.finite", Other: "值必須是有限的"},
+		{ID: "double.gt", Other: "值必須大於 {{.Value}}"},
+		{ID: "double.gt_lt", Other: "值必須大於 {{.Value}} 且小於 {{.Value}}"},
+		{ID: "double.gt_lt_exclusive", Other: "值必須大於 {{.Value}} 或小於 {{.Value}}"},
+		{ID: "double.gt_lte", Other: "值必須大於 {{.Value}} 且小於或等於 {{.Value}}"},
+		{ID: "double.gt_lte_exclusive", Other: "值必須大於 {{.Value}} 或小於或等於 {{.Value}}"},
+		{ID: "double.gte", Other: "值必須大於或等於 {{.Value}}"},
+		{ID: "double.gte_lt", Other: "值必須大於或等於 {{.Value}} 且小於 {{.Value}}"},
+		{ID: "double.gte_lt_exclusive", Other: "值必須大於或等於 {{.Value}} 或小於 {{.Value}}"},
+		{ID: "double.gte_lte", Other: "值必須大於或等於 {{.Value}} 且小於或等於 {{.Value}}"},
+		{ID: "double.gte_lte_exclusive", Other: "值必須大於或等於 {{.Value}} 或小於或等於 {{.Value}}"},
+		{ID: "double.in", Other: "值必須在列表 {{.Value}} 中"},
+		{ID: "double.lt", Other: "值必須小於 {{.Value}}"},
+		{ID: "double.lte", Other: "值必須小於或等於 {{.Value}}"},
+		{ID: "double.not_in", Other: "值不能在列表 {{.Value}} 中"},
+		{ID: "duration.const", Other: "值必須等於 {{.Value}}"},
+		{ID: "duration.gt", Other: "值必須大於 {{.Value}}"},
+		{ID: "duration.gt_lt", Other: "值必須大於 {{.Value}} 且小於 {{.Value}}"},
+		{ID: "duration.gt_lt_exclusive", Other: "值必須大於 {{.Value}} 或小於 {{.Value}}"},
+		{ID: "duration.gt_lte", Other: "值必須大於 {{.Value}} 且小於或等於 {{.Value}}"},
+		{ID: "duration.gt_lte_exclusive", Other: "值必須大於 {{.Value}} 或小於或等於 {{.Value}}"},
+		{ID: "duration.gte", Other: "值必須大於或等於 {{.Value}}"},
+		{ID: "duration.gte_lt", Other: "值必須大於或等於 {{.Value}} 且小於 {{.Value}}"},
+		{ID: "duration.gte_lt_exclusive", Other: "值必須大於 {{.Value}} 或小於 {{.Value}}"},
+		{ID: "duration.gte_lte", Other: "值必須大於或等於 {{.Value}} 且小於或等於 {{.Value}}"},
+		{ID: "duration.gte_lte_exclusive", Other: "值必須大於 {{.Value}} 或小於或等於 {{.Value}}"},
+		{ID: "duration.in", Other: "值必須在列表 {{.Value}} 中"},
+		{ID: "duration.lt", Other: "值必須小於 {{.Value}}"},
+		{ID: "duration.lte", Other: "值必須小於或等於 {{.Value}}"},
+		{ID: "duration.not_in", Other: "值不能在列表 {{.Value}} 中"},
+		{ID: "enum.const", Other: "值必須等於 {{.Value}}"},
+		{ID: "enum.in", Other: "值必須在列表 {{.Value}} 中"},
+		{ID: "enum.not_in", Other: "值不能在列表 {{.Value}} 中"},
+		{ID: "field_path.index", Other: "第 {{.Index}} 項"},
+		{ID: "field_path.key", Other: "「{{.Key}}」"},
+		{ID: "field_path.separator", Other: " › "},
+		{ID: "fixed32.const", Other: "值必須等於 {{.Value}}"},
+		{ID: "fixed32.gt", Other: "值必須大於 {{.Value}}"},
+		{ID: "fixed32.gt_lt", Other: "值必須大於 {{.Value}} 且小於 {{.Value}}"},
+		{ID: "fixed32.gt_lt_exclusive", Other: "值必須大於 {{.Value}} 或小於 {{.Value}}"},
+		{ID: "fixed32.gt_lte", Other: "值必須大於 {{.Value}} 且小於或等於 {{.Value}}"},
+		{ID: "fixed32.gt_lte_exclusive", Other: "值必須大於 {{.Value}} 或小於或等於 {{.Value}}"},
+		{ID: "fixed32.gte", Other: "值必須大於或等於 {{.Value}}"},
+		{ID: "fixed32.gte_lt", Other: "值必須大於 {{.Value}} 且小於 {{.Value}}"},
+		{ID: "fixed32.gte_lt_exclusive", Other: "值必須大於 {{.Value}} 或小於 {{.Value}}"},
+		{ID: "fixed32.gte_lte", Other: "值必須大於 {{.Value}} 且小於或等於 {{.Value}}"},
+		{ID: "fixed32.gte_lte_exclusive", Other: "值必須大於 {{.Value}} 或小於或等於 {{.Value}}"},
+		{ID: "fixed32.in", Other: "值必須在列表 {{.Value}} 中"},
+		{ID: "fixed32.lt", Other: "值必須小於 {{.Value}}"},
+		{ID: "fixed32.lte", Other: "值必須小於或等於 {{.Value}}"},
+		{ID: "fixed32.not_in", Other: "值不能在列表 {{.Value}} 中"},
+		{ID: "fixed64.const", Other: "值必須等於 {{.Value}}"},
+		{ID: "fixed64.gt", Other: "值必須大於 {{.Value}}"},
+		{ID: "fixed64.gt_lt", Other: "值必須大於 {{.Value}} 且小於 {{.Value}}"},
+		{ID: "fixed64.gt_lt_exclusive", Other: "值必須大於 {{.Value}} 或小於 {{.Value}}"},
+		{ID: "fixed64.gt_lte", Other: "值必須大於 {{.Value}} 且小於或等於 {{.Value}}"},
+		{ID: "fixed64.gt_lte_exclusive", Other: "值必須大於 {{.Value}} 或小於或等於 {{.Value}}"},
+		{ID: "fixed64.gte", Other: "值必須大於或等於 {{.Value}}"},
+		{ID: "fixed64.gte_lt", Other: "值必須大於 {{.Value}} 且小於 {{.Value}}"},
+		{ID: "fixed64.gte_lt_exclusive", Other: "值必須大於 {{.Value}} 或小於 {{.Value}}"},
+		{ID: "fixed64.gte_lte", Other: "值必須大於 {{.Value}} 且小於或等於 {{.Value}}"},
+		{ID: "fixed64.gte_lte_exclusive", Other: "值必須大於 {{.Value}} 或小於或等於 {{.Value}}"},
+		{ID: "fixed64.in", Other: "值必須在列表 {{.Value}} 中"},
+		{ID: "fixed64.lt", Other: "值必須小於 {{.Value}}"},
+		{ID: "fixed64.lte", Other: "值必須小於或等於 {{.Value}}"},
+		{ID: "fixed64.not_in", Other: "值不能在列表 {{.Value}} 中"},
+		{ID: "float.const", Other: "值必須等於 {{.Value}}"},
+		{ID: "float.finite", Other: "值必須是有限的"},
+		{ID: "float.gt", Other: "值必須大於 {{.Value}}"},
+		{ID: "float.gt_lt", Other: "值必須大於 {{.Value}} 且小於 {{.Value}}"},
+		{ID: "float.gt_lt_exclusive", Other: "值必須大於 {{.Value}} 或小於 {{.Value}}"},
+		{ID: "float.gt_lte", Other: "值必須大於 {{.Value}} 且小於或等於 {{.Value}}"},
+		{ID: "float.gt_lte_exclusive", Other: "值必須大於 {{.Value}} 或小於或等於 {{.Value}}"},
+		{ID: "float.gte", Other: "值必須大於或等於 {{.Value}}"},
+		{ID: "float.gte_lt", Other: "值必須大於或等於 {{.Value}} 且小於 {{.Value}}"},
+		{ID: "float.gte_lt_exclusive", Other: "值必須大於或等於 {{.Value}} 或小於 {{.Value}}"},
+		{ID: "float.gte_lte", Other: "值必須大於或等於 {{.Value}} 且小於或等於 {{.Value}}"},
+		{ID: "float.gte_lte_exclusive", Other: "值必須大於或等於 {{.Value}} 或小於或等於 {{.Value}}"},
+		{ID: "float.in", Other: "值必須在列表 {{.Value}} 中"},
+		{ID: "float.lt", Other: "值必須小於 {{.Value}}"},
+		{ID: "float.lte", Other: "值必須小於或等於 {{.Value}}"},
+		{ID: "float.not_in", Other: "值不能在列表 {{.Value}} 中"},
+		{ID: "int32.const", Other: "值必須等於 {{.Value}}"},
+		{ID: "int32.gt", Other: "值必須大於 {{.Value}}"},
+		{ID: "int32.gt_lt", Other: "值必須大於 {{.Value}} 且小於 {{.Value}}"},
+		{ID: "int32.gt_lt_exclusive", Other: "值必須大於 {{.Value}} 或小於 {{.Value}}"},
+		{ID: "int32.gt_lte", Other: "值必須大於 {{.Value}} 且小於或等於 {{.Value}}"},
+		{ID: "int32.gt_lte_exclusive", Other: "值必須大於 {{.Value}} 或小於或等於 {{.Value}}"},
+		{ID: "int32.gte", Other: "值必須大於或等於 {{.Value}}"},
+		{ID: "int32.gte_lt", Other: "值必須大於或等於 {{.Value}} 且小於 {{.Value}}"},
+		{ID: "int32.gte_lt_exclusive", Other: "值必須大於或等於 {{.Value}} 或小於 {{.Value}}"},
+		{ID: "int32.gte_lte", Other: "值必須大於或等於 {{.Value}} 且小於或等於 {{.Value}}"},
+		{ID: "int32.gte_lte_exclusive", Other: "值必須大於或等於 {{.Value}} 或小於或等於 {{.Value}}"},
+		{ID: "int32.in", Other: "值必須在列表 {{.Value}} 中"},
+		{ID: "int32.lt", Other: "值必須小於 {{.Value}}"},
+		{ID: "int32.lte", Other: "值必須小於或等於 {{.Value}}"},
+		{ID: "int32.not_in", Other: "值不能在列表 {{.Value}} 中"},
+		{ID: "int64.const", Other: "值必須等於 {{.Value}}"},
+		{ID: "int64.gt", Other: "值必須大於 {{.Value}}"},
+		{ID: "int64.gt_lt", Other: "值必須大於 {{.Value}} 且小於 {{.Value}}"},
+		{ID: "int64.gt_lt_exclusive", Other: "值必須大於 {{.Value}} 或小於 {{.Value}}"},
+		{ID: "int64.gt_lte", Other: "值必須大於 {{.Value}} 且小於或等於 {{.Value}}"},
+		{ID: "int64.gt_lte_exclusive", Other: "值必須大於 {{.Value}} 或小於或等於 {{.Value}}"},
+		{ID: "int64.gte", Other: "值必須大於或等於 {{.Value}}"},
+		{ID: "int64.gte_lt", Other: "值必須大於或等於 {{.Value}} 且小於 {{.Value}}"},
+		{ID: "int64.gte_lt_exclusive", Other: "值必須大於 {{.Value}} 或小於 {{.Value}}"},
+		{ID: "int64.gte_lte", Other: "值必須大於或等於 {{.Value}} 且小於或等於 {{.Value}}"},
+		{ID: "int64.gte_lte_exclusive", Other: "值必須大於 {{.Value}} 或小於 {{.Value}}"},
+		{ID: "int64.in", Other: "值必須在列表 {{.Value}} 中"},
+		{ID: "int64.lt", Other: "值必須小於 {{.Value}}"},
+		{ID: "int64.lte", Other: "值必須小於或等於 {{.Value}}"},
+		{ID: "int64.not_in", Other: "值不能在列表 {{.Value}} 中"},
+		{ID: "map.max_pairs", Other: "映射最多只能包含 {{.Value}} 個條目"},
+		{ID: "map.min_pairs", Other: "映射必須至少包含 {{.Value}} 個條目"},
+		{ID: "repeated.max_items", Other: "值必須最多包含 {{.Value}} 個项目"},
+		{ID: "repeated.min_items", Other: "值必須至少包含 {{.Value}} 個项目"},
+		{ID: "repeated.unique", Other: "重复的值必須包含唯一的项目"},
+		{ID: "sfixed32.const", Other: "值必須等於 {{.Value}}"},
+		{ID: "sfixed32.gt", Other: "值必須大於 {{.Value}}"},
+		{ID: "sfixed32.gt_lt", Other: "值必須大於 {{.Value}} 且小於 {{.Value}}"},
+		{ID: "sfixed32.gt_lt_exclusive", Other: "值必須大於 {{.Value}} 或小於 {{.Value}}"},
+		{ID: "sfixed32.gt_lte", Other: "值必須大於 {{.Value}} 且小於或等於 {{.Value}}"},
+		{ID: "sfixed32.gt_lte_exclusive", Other: "值必須大於 {{.Value}} 或小於或等於 {{.Value}}"},
+		{ID: "sfixed32.gte", Other: "值必須大於或等於 {{.Value}}"},
+		{ID: "sfixed32.gte_lt", Other: "值必須大於 {{.Value}} 且小於 {{.Value}}"},
+		{ID: "sfixed32.gte_lt_exclusive", Other: "值必須大於 {{.Value}} 或小於 {{.Value}}"},
+		{ID: "sfixed32.gte_lte", Other: "值必須大於 {{.Value}} 且小於或等於 {{.Value}}"},
+		{ID: "sfixed32.gte_lte_exclusive", Other: "值必須大於 {{.Value}} 或小於或等於 {{.Value}}"},
+		{ID: "sfixed32.in", Other: "值必須在列表 {{.Value}} 中"},
+		{ID: "sfixed32.lt", Other: "值必須小於 {{.Value}}"},
+		{ID: "sfixed32.lte", Other: "值必須小於或等於 {{.Value}}"},
+		{ID: "sfixed32.not_in", Other: "值不能在列表 {{.Value}} 中"},
+		{ID: "sfixed64.const", Other: "值必須等於 {{.Value}}"},
+		{ID: "sfixed64.gt", Other: "值必須大於 {{.Value}}"},
+		{ID: "sfixed64.gt_lt", Other: "值必須大於 {{.Value}} 且小於 {{.Value}}"},
+		{ID: "sfixed64.gt_lt_exclusive", Other: "值必須大於 {{.Value}} 或小於 {{.Value}}"},
+		{ID: "sfixed64.gt_lte", Other: "值必須大於 {{.Value}} 且小於或等於 {{.Value}}"},
+		{ID: "sfixed64.gt_lte_exclusive", Other: "值必須大於 {{.Value}} 或小於或等於 {{.Value}}"},
+		{ID: "sfixed64.gte", Other: "值必須大於或等於 {{.Value}}"},
+		{ID: "sfixed64.gte_lt", Other: "值必須大於 {{.Value}} 且小於 {{.Value}}"},
+		{ID: "sfixed64.gte_lt_exclusive", Other: "值必須大於 {{.Value}} 或小於 {{.Value}}"},
+		{ID: "sfixed64.gte_lte", Other: "值必須大於 {{.Value}} 且小於或等於 {{.Value}}"},
+		{ID: "sfixed64.gte_lte_exclusive", Other: "值必須大於 {{.Value}} 或小於或等於 {{.Value}}"},
+		{ID: "sfixed64.in", Other: "值必須在列表 {{.Value}} 中"},
+		{ID: "sfixed64.lt", Other: "值必須小於 {{.Value}}"},
+		{ID: "sfixed64.lte", Other: "值必須小於或等於 {{.Value}}"},
+		{ID: "sfixed64.not_in", Other: "值不能在列表 {{.Value}} 中"},
+		{ID: "sint32.const", Other: "值必須等於 {{.Value}}"},
+		{ID: "sint32.gt", Other: "值必須大於 {{.Value}}"},
+		{ID: "sint32.gt_lt", Other: "值必須大於 {{.Value}} 且小於 {{.Value}}"},
+		{ID: "sint32.gt_lt_exclusive", Other: "值必須大於 {{.Value}} 或小於 {{.Value}}"},
+		{ID: "sint32.gt_lte", Other: "值必須大於 {{.Value}} 且小於或等於 {{.Value}}"},
+		{ID: "sint32.gt_lte_exclusive", Other: "值必須大於 {{.Value}} 或小於或等於 {{.Value}}"},
+		{ID: "sint32.gte", Other: "值必須大於或等於 {{.Value}}"},
+		{ID: "sint32.gte_lt", Other: "值必須大於或等於 {{.Value}} 且小於 {{.Value}}"},
+		{ID: "sint32.gte_lt_exclusive", Other: "值必須大於 {{.Value}} 或小於 {{.Value}}"},
+		{ID: "sint32.gte_lte", Other: "值必須大於 {{.Value}} 且小於或等於 {{.Value}}"},
+		{ID: "sint32.gte_lte_exclusive", Other: "值必須大於 {{.Value}} 或小於或等於 {{.Value}}"},
+		{ID: "sint32.in", Other: "值必須在列表 {{.Value}} 中"},
+		{ID: "sint32.lt", Other: "值必須小於 {{.Value}}"},
+		{ID: "sint32.lte", Other: "值必須小於或等於 {{.Value}}"},
+		{ID: "sint32.not_in", Other: "值不能在列表 {{.Value}} 中"},
+		{ID: "sint64.const", Other: "值必須等於 {{.Value}}"},
+		{ID: "sint64.gt", Other: "值必須大於 {{.Value}}"},
+		{ID: "sint64.gt_lt", Other: "值必須大於 {{.Value}} 且小於 {{.Value}}"},
+		{ID: "sint64.gt_lt_exclusive", Other: "值必須大於 {{.Value}} 或小於 {{.Value}}"},
+		{ID: "sint64.gt_lte", Other: "值必須大於 {{.Value}} 且小於或等於 {{.Value}}"},
+		{ID: "sint64.gt_lte_exclusive", Other: "值必須大於 {{.Value}} 或小於或等於 {{.Value}}"},
+		{ID: "sint64.gte", Other: "值必須大於或等於 {{.Value}}"},
+		{ID: "sint64.gte_lt", Other: "值必須大於 {{.Value}} 且小於 {{.Value}}"},
+		{ID: "sint64.gte_lt_exclusive", Other: "值必須大於 {{.Value}} 或小於 {{.Value}}"},
+		{ID: "sint64.gte_lte", Other: "值必須大於 {{.Value}} 且小於或等於 {{.Value}}"},
+		{ID: "sint64.gte_lte_exclusive", Other: "值必須大於 {{.Value}} 或小於或等於 {{.Value}}"},
+		{ID: "sint64.in", Other: "值必須在列表 {{.Value}} 中"},
+		{ID: "sint64.lt", Other: "值必須小於 {{.Value}}"},
+		{ID: "sint64.lte", Other: "值必須小於或等於 {{.Value}}"},
+		{ID: "sint64.not_in", Other: "值不能在列表 {{.Value}} 中"},
+		{ID: "string.address", Other: "值必須是有效的主机名或 IP 地址"},
+		{ID: "string.address_empty", Other: "值為空，不是有效的主机名或 IP 地址"},
+		{ID: "string.const", Other: "值必須等於 {{.Value}}"},
+		{ID: "string.contains", Other: "值不包含子字符串 {{.Value}}"},
+		{ID: "string.email", Other: "值必須是有效的電子郵件地址"},
+		{ID: "string.email_empty", Other: "值為空，不是有效的電子郵件地址"},
+		{ID: "string.host_and_port", Other: "值必須是有效的主机（主机名或 IP 地址）和端口對"},
+		{ID: "string.host_and_port_empty", Other: "值為空，不是有效的主机和端口對"},
+		{ID: "string.hostname", Other: "值必須是有效的主机名"},
+		{ID: "string.hostname_empty", Other: "值為空，不是有效的主机名"},
+		{ID: "string.in", Other: "值必須在列表 {{.Value}} 中"},
+		{ID: "string.ip", Other: "值必須是有效的 IP 地址"},
+		{ID: "string.ip_empty", Other: "值為空，不是有效的 IP 地址"},
+		{ID: "string.ip_prefix", Other: "值必須是有效的 IP 前綴"},
+		{ID: "string.ip_prefix_empty", Other: "值為空，不是有效的 IP 前綴"},
+		{ID: "string.ip_with_prefixlen", Other: "值必須是有效的 IP 前綴"},
+		{ID: "string.ip_with_prefixlen_empty", Other: "值為空，不是有效的 IP 前綴"},
+		{ID: "string.ipv4", Other: "值必須是有效的 IPv4 地址"},
+		{ID: "string.ipv4_empty", Other: "值為空，不是有效的 IPv4 地址"},
+		{ID: "string.ipv4_prefix", Other: "值必須是有效的 IPv4 前綴"},
+		{ID: "string.ipv4_prefix_empty", Other: "值為空，不是有效的 IPv4 前綴"},
+		{ID: "string.ipv4_with_prefixlen", Other: "值必須是有效的 IPv4 地址帶前綴長度"},
+		{ID: "string.ipv4_with_prefixlen_empty", Other: "值為空，不是有效的 IPv4 地址帶前綴長度"},
+		{ID: "string.ipv6", Other: "值必須是有效的 IPv6 地址"},
+		{ID: "string.ipv6_empty", Other: "值為空，不是有效的 IPv6 地址"},
+		{ID: "string.ipv6_prefix", Other: "值必須是有效的 IPv6 前綴"},
+		{ID: "string.ipv6_prefix_empty", Other: "值為空，不是有效的 IPv6 前綴"},
+		{ID: "string.ipv6_with_prefixlen", Other: "值必須是有效的 IPv6 地址帶前綴長度"},
+		{ID: "string.ipv6_with_prefixlen_empty", Other: "值為空，不是有效的 IPv6 地址帶前綴長度"},
+		{ID: "string.len", Other: "值長度必須為 {{.Value}} 個字符"},
+		{ID: "string.len_bytes", Other: "值長度必須為 {{.Value}} 字節"},
+		{ID: "string.max_bytes", Other: "值長度必須最多為 {{.Value}} 字節"},
+		{ID: "string.max_len", Other: "值長度必須最多為 {{.Value}} 個字符"},
+		{ID: "string.min_bytes", Other: "值長度必須至少為 {{.Value}} 字節"},
+		{ID: "string.min_len", Other: "值長度必須至少為 {{.Value}} 個字符"},
+		{ID: "string.not_contains", Other: "值包含子字符串 {{.Value}}"},
+		{ID: "string.not_in", Other: "值不能在列表 {{.Value}} 中"},
+		{ID: "string.pattern", Other: "值不符合正則表達式模式 {{.Value}}"},
+		{ID: "string.prefix", Other: "值没有前綴 {{.Value}}"},
+		{ID: "string.suffix", Other: "值没有后綴 {{.Value}}"},
+		{ID: "string.tuuid", Other: "值必須是有效的裁剪 UUID"},
+		{ID: "string.tuuid_empty", Other: "值為空，不是有效的裁剪 UUID"},
+		{ID: "string.uri", Other: "值必須是有效的 URI"},
+		{ID: "string.uri_empty", Other: "值為空，不是有效的 URI"},
+		{ID: "string.uri_ref", Other: "值必須是有效的 URI 引用"},
+		{ID: "string.uuid", Other: "值必須是有效的 UUID"},
+		{ID: "string.uuid_empty", Other: "值為空，不是有效的 UUID"},
+		{ID: "string.well_known_regex.header_name", Other: "值必須是有效的 HTTP 头部名称"},
+		{ID: "string.well_known_regex.header_name_empty", Other: "值為空，不是有效的 HTTP 头部名称"},
+		{ID: "string.well_known_regex.header_value", Other: "值必須是有效的 HTTP 头部值"},
+		{ID: "timestamp.const", Other: "值必須等於 {{.Value}}"},
+		{ID: "timestamp.gt", Other: "值必須大於 {{.Value}}"},
+		{ID: "timestamp.gt_lt", Other: "值必須大於 {{.Value}} 且小於 {{.Value}}"},
+		{ID: "timestamp.gt_lt_exclusive", Other: "值必須大於 {{.Value}} 或小於 {{.Value}}"},
+		{ID: "timestamp.gt_lte", Other: "值必須大於 {{.Value}} 且小於或等於 {{.Value}}"},
+		{ID: "timestamp.gt_lte_exclusive", Other: "值必須大於 {{.Value}} 或小於或等於 {{.Value}}"},
+		{ID: "timestamp.gt_now", Other: "值必須大於現在"},
+		{ID: "timestamp.gte", Other: "值必須大於或等於 {{.Value}}"},
+		{ID: "timestamp.gte_lt", Other: "值必須大於或等於 {{.Value}} 且小於 {{.Value}}"},
+		{ID: "timestamp.gte_lt_exclusive", Other: "值必須大於 {{.Value}} 或小於 {{.Value}}"},
+		{ID: "timestamp.gte_lte", Other: "值必須大於或等於 {{.Value}} 且小於或等於 {{.Value}}"},
+		{ID: "timestamp.gte_lte_exclusive", Other: "值必須大於 {{.Value}} 或小於或等於 {{.Value}}"},
+		{ID: "timestamp.lt", Other: "值必須小於 {{.Value}}"},
+		{ID: "timestamp.lt_now", Other: "值必須小於現在"},
+		{ID: "timestamp.lte", Other: "值必須小於或等於 {{.Value}}"},
+		{ID: "timestamp.within", Other: "值必須在現在的 {{.Value}} 範圍內"},
+		{ID: "uint32.const", Other: "值必須等於 {{.Value}}"},
+		{ID: "uint32.gt", Other: "值必須大於 {{.Value}}"},
+		{ID: "uint32.gt_lt", Other: "值必須大於 {{.Value}} 且小於 {{.Value}}"},
+		{ID: "uint32.gt_lt_exclusive", Other: "值必須大於 {{.Value}} 或小於 {{.Value}}"},
+		{ID: "uint32.gt_lte", Other: "值必須大於 {{.Value}} 且小於或等於 {{.Value}}"},
+		{ID: "uint32.gt_lte_exclusive", Other: "值必須大於 {{.Value}} 或小於或等於 {{.Value}}"},
+		{ID: "uint32.gte", Other: "值必須大於或等於 {{.Value}}"},
+		{ID: "uint32.gte_lt", Other: "值必須大於或等於 {{.Value}} 且小於 {{.Value}}"},
+		{ID: "uint32.gte_lt_exclusive", Other: "值必須大於 {{.Value}} 或小於 {{.Value}}"},
+		{ID: "uint32.gte_lte", Other: "值必須大於 {{.Value}} 且小於或等於 {{.Value}}"},
+		{ID: "uint32.gte_lte_exclusive", Other: "值必須大於 {{.Value}} 或小於或等於 {{.Value}}"},
+		{ID: "uint32.in", Other: "值必須在列表 {{.Value}} 中"},
+		{ID: "uint32.lt", Other: "值必須小於 {{.Value}}"},
+		{ID: "uint32.lte", Other: "值必須小於或等於 {{.Value}}"},
+		{ID: "uint32.not_in", Other: "值不能在列表 {{.Value}} 中"},
+		{ID: "uint64.const", Other: "值必須等於 {{.Value}}"},
+		{ID: "uint64.gt", Other: "值必須大於 {{.Value}}"},
+		{ID: "uint64.gt_lt", Other: "值必須大於 {{.Value}} 且小於 {{.Value}}"},
+		{ID: "uint64.gt_lt_exclusive", Other: "值必須大於 {{.Value}} 或小於 {{.Value}}"},
+		{ID: "uint64.gt_lte", Other: "值必須大於 {{.Value}} 且小於或等於 {{.Value}}"},
+		{ID: "uint64.gt_lte_exclusive", Other: "值必須大於 {{.Value}} 或小於或等於 {{.Value}}"},
+		{ID: "uint64.gte", Other: "值必須大於或等於 {{.Value}}"},
+		{ID: "uint64.gte_lt", Other: "值必須大於 {{.Value}} 且小於 {{.Value}}"},
+		{ID: "uint64.gte_lt_exclusive", Other: "值必須大於 {{.Value}} 或小於 {{.Value}}"},
+		{ID: "uint64.gte_lte", Other: "值必須大於 {{.Value}} 且小於或等於 {{.Value}}"},
+		{ID: "uint64.gte_lte_exclusive", Other: "值必須大於 {{.Value}} 或小於或等於 {{.Value}}"},
+		{ID: "uint64.in", Other: "值必須在列表 {{.Value}} 中"},
+		{ID: "uint64.lt", Other: "值必須小於 {{.Value}}"},
+		{ID: "uint64.lte", Other: "值必須小於或等於 {{.Value}}"},
+		{ID: "uint64.not_in", Other: "值不能在列表 {{.Value}} 中"},
+	}, map[string][]templatePart{
+		"值必須等於 {{.Value}}":                      {{text: "值必須等於 "}, {field: "Value"}},
+		"值必須是 {{.Value}}":                       {{text: "值必須是 "}, {field: "Value"}},
+		"值不包含 {{.Value}}":                       {{text: "值不包含 "}, {field: "Value"}},
+		"值必須在列表 {{.Value}} 中":                   {{text: "值必須在列表 "}, {field: "Value"}, {text: " 中"}},
+		"值長度必須為 {{.Value}} 字節":                  {{text: "值長度必須為 "}, {field: "Value"}, {text: " 字節"}},
+		"值長度必須最多為 {{.Value}} 字節":                {{text: "值長度必須最多為 "}, {field: "Value"}, {text: " 字節"}},
+		"值長度必須至少為 {{.Value}} 字節":                {{text: "值長度必須至少為 "}, {field: "Value"}, {text: " 字節"}},
+		"值不能在列表 {{.Value}} 中":                   {{text: "值不能在列表 "}, {field: "Value"}, {text: " 中"}},
+		"值必須匹配正則表達式模式 {{.Value}}":               {{text: "值必須匹配正則表達式模式 "}, {field: "Value"}},
+		"值没有前綴 {{.Value}}":                      {{text: "值没有前綴 "}, {field: "Value"}},
+		"值没有后綴 {{.Value}}":                      {{text: "值没有后綴 "}, {field: "Value"}},
+		"值必須大於 {{.Value}}":                      {{text: "值必須大於 "}, {field: "Value"}},
+		"值必須大於 {{.Value}} 且小於 {{.Value}}":       {{text: "值必須大於 "}, {field: "Value"}, {text: " 且小於 "}, {field: "Value"}},
+		"值必須大於 {{.Value}} 或小於 {{.Value}}":       {{text: "值必須大於 "}, {field: "Value"}, {text: " 或小於 "}, {field: "Value"}},
+		"值必須大於 {{.Value}} 且小於或等於 {{.Value}}":    {{text: "值必須大於 "}, {field: "Value"}, {text: " 且小於或等於 "}, {field: "Value"}},
+		"值必須大於 {{.Value}} 或小於或等於 {{.Value}}":    {{text: "值必須大於 "}, {field: "Value"}, {text: " 或小於或等於 "}, {field: "Value"}},
+		"值必須大於或等於 {{.Value}}":                   {{text: "值必須大於或等於 "}, {field: "Value"}},
+		"值必須大於或等於 {{.Value}} 且小於 {{.Value}}":    {{text: "值必須大於或等於 "}, {field: "Value"}, {text: " 且小於 "}, {field: "Value"}},
+		"值必須大於或等於 {{.Value}} 或小於 {{.Value}}":    {{text: "值必須大於或等於 "}, {field: "Value"}, {text: " 或小於 "}, {field: "Value"}},
+		"值必須大於或等於 {{.Value}} 且小於或等於 {{.Value}}": {{text: "值必須大於或等於 "}, {field: "Value"}, {text: " 且小於或等於 "}, {field: "Value"}},
+		"值必須大於或等於 {{.Value}} 或小於或等於 {{.Value}}": {{text: "值必須大於或等於 "}, {field: "Value"}, {text: " 或小於或等於 "}, {field: "Value"}},
+		"值必須小於 {{.Value}}":                      {{text: "值必須小於 "}, {field: "Value"}},
+		"值必須小於或等於 {{.Value}}":                   {{text: "值必須小於或等於 "}, {field: "Value"}},
+		"第 {{.Index}} 項":                        {{text: "第 "}, {field: "Index"}, {text: " 項"}},
+		"「{{.Key}}」":                            {{text: "「"}, {field: "Key"}, {text: "」"}},
+		"映射最多只能包含 {{.Value}} 個條目":               {{text: "映射最多只能包含 "}, {field: "Value"}, {text: " 個條目"}},
+		"映射必須至少包含 {{.Value}} 個條目":               {{text: "映射必須至少包含 "}, {field: "Value"}, {text: " 個條目"}},
+		"值必須最多包含 {{.Value}} 個项目":                {{text: "值必須最多包含 "}, {field: "Value"}, {text: " 個项目"}},
+		"值必須至少包含 {{.Value}} 個项目":                {{text: "值必須至少包含 "}, {field: "Value"}, {text: " 個项目"}},
+		"值不包含子字符串 {{.Value}}":                   {{text: "值不包含子字符串 "}, {field: "Value"}},
+		"值長度必須為 {{.Value}} 個字符":                 {{text: "值長度必須為 "}, {field: "Value"}, {text: " 個字符"}},
+		"值長度必須最多為 {{.Value}} 個字符":               {{text: "值長度必須最多為 "}, {field: "Value"}, {text: " 個字符"}},
+		"值長度必須至少為 {{.Value}} 個字符":               {{text: "值長度必須至少為 "}, {field: "Value"}, {text: " 個字符"}},
+		"值包含子字符串 {{.Value}}":                    {{text: "值包含子字符串 "}, {field: "Value"}},
+		"值不符合正則表達式模式 {{.Value}}":                {{text: "值不符合正則表達式模式 "}, {field: "Value"}},
+		"值必須在現在的 {{.Value}} 範圍內":                {{text: "值必須在現在的 "}, {field: "Value"}, {text: " 範圍內"}},
+	})
+}
@@ -0,0 +1,87 @@
+package translator
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+)
+
+type (
+	languageKey struct{}
+	fallbackKey struct{}
+	bundleKey   struct{}
+)
+
+// WithLanguage returns a copy of ctx carrying the language used by TranslateCtx.
+// lang is a BCP 47 tag or an Accept-Language header value.
+func WithLanguage(ctx context.Context, lang string) context.Context {
+	return context.WithValue(ctx, languageKey{}, lang)
+}
+
+// LanguageFromContext returns the language stored by WithLanguage, or "".
+func LanguageFromContext(ctx context.Context) string {
+	lang, _ := ctx.Value(languageKey{}).(string)
+	return lang
+}
+
+// WithFallback returns a copy of ctx carrying the fallback languages tried, in order,
+// when the context language has no message. It replaces DefaultLang as the fallback.
+func WithFallback(ctx context.Context, langs ...string) context.Context {
+	return context.WithValue(ctx, fallbackKey{}, append([]string(nil), langs...))
+}
+
+// FallbackFromContext returns the fallback languages stored by WithFallback,
+// or []string{DefaultLang} if none were set.
+func FallbackFromContext(ctx context.Context) []string {
+	if langs, ok := ctx.Value(fallbackKey{}).([]string); ok {
+		return append([]string(nil), langs...)
+	}
+	return []string{DefaultLang}
+}
+
+// WithBundle returns a copy of ctx carrying the bundle used by TranslateCtx.
+func WithBundle(ctx context.Context, bundle *i18n.Bundle) context.Context {
+	return context.WithValue(ctx, bundleKey{}, bundle)
+}
+
+// BundleFromContext returns the bundle stored by WithBundle.
+func BundleFromContext(ctx context.Context) (*i18n.Bundle, bool) {
+	bundle, ok := ctx.Value(bundleKey{}).(*i18n.Bundle)
+	return bundle, ok && bundle != nil
+}
+
+// TranslateCtx renders a message by id using the language, fallback chain and bundle
+// carried by ctx. Without a bundle in ctx the default bundle is used.
+func TranslateCtx(ctx context.Context, id string, data map[string]any) (string, error) {
+	bundle, ok := BundleFromContext(ctx)
+	if !ok {
+		var err error
+		if bundle, err = DefaultBundle(); err != nil {
+			return "", err
+		}
+	}
+	langs := append([]string{LanguageFromContext(ctx)}, FallbackFromContext(ctx)...)
+	res, err := translateChain(ctx, bundle, langs, id, data)
+	return res.Message, err
+}
+
+// MustTranslateCtx is like TranslateCtx but panics on error.
+func MustTranslateCtx(ctx context.Context, id string, data map[string]any) string {
+	msg, err := TranslateCtx(ctx, id, data)
+	if err != nil {
+		panic(err)
+	}
+	return msg
+}
+
+// LanguageHandler wraps next so that request contexts carry the request's
+// Accept-Language header as language (see WithLanguage).
+func LanguageHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if lang := r.Header.Get("Accept-Language"); lang != "" {
+			r = r.WithContext(WithLanguage(r.Context(), lang))
+		}
+		next.ServeHTTP(w, r)
+	})
+}
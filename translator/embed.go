@@ -1,8 +1,8 @@
 package translator
 
 import (
-	"embed"
 	"io/fs"
+	"path"
 	"sync"
 
 	"github.com/nicksnyder/go-i18n/v2/i18n"
@@ -16,34 +16,34 @@ const (
 	DefaultLang = "en"
 )
 
-// LocalesFS embeds the default locale files.
-//
-//go:embed locales/*.json
-var LocalesFS embed.FS
+// LocaleSelectBuildTag is the build tag that embeds only DefaultLang and the languages
+// enabled with a translator_locale_<lang> tag (lowercase, "-" replaced by "_"),
+// e.g. -tags translator_select_locales,translator_locale_zh embeds en and zh.
+const LocaleSelectBuildTag = "translator_select_locales"
 
 // BundleCustomizer is a function that extends a bundle (e.g. load more files or add messages).
 // It is applied when building the default bundle, after the embedded locales are loaded.
 type BundleCustomizer func(b *i18n.Bundle) error
 
 var (
-	defaultBundleOnce    sync.Once
-	defaultBundle        *i18n.Bundle
-	defaultBundleErr     error
-	defaultCustomizersMu sync.Mutex
-	defaultCustomizers   []BundleCustomizer
+	defaultMu          sync.RWMutex
+	defaultBundle      *i18n.Bundle
+	defaultCustomizers []BundleCustomizer
 )
 
-// AddDefaultBundleCustomizer registers a customizer that will run when the default bundle
-// is first built (on first call to DefaultBundle). Register before any call to DefaultBundle.
+// AddDefaultBundleCustomizer registers a customizer for the default bundle.
+// Registering after the default bundle has been built causes it to be rebuilt,
+// with all customizers, on the next call to DefaultBundle.
 func AddDefaultBundleCustomizer(fn BundleCustomizer) {
-	defaultCustomizersMu.Lock()
-	defer defaultCustomizersMu.Unlock()
+	defaultMu.Lock()
+	defer defaultMu.Unlock()
 	defaultCustomizers = append(defaultCustomizers, fn)
+	dropDefaultBundle()
 }
 
 // AddDefaultLocaleFile registers a locale file to load into the default bundle.
 // The path is passed to bundle.LoadMessageFile (e.g. "locales/custom.json").
-// Register before first use of DefaultBundle.
+// See AddDefaultBundleCustomizer.
 func AddDefaultLocaleFile(path string) {
 	AddDefaultBundleCustomizer(func(b *i18n.Bundle) error {
 		_, err := b.LoadMessageFile(path)
@@ -52,7 +52,7 @@ func AddDefaultLocaleFile(path string) {
 }
 
 // AddDefaultLocaleFromFS registers a locale file from an fs.FS (e.g. embed.FS) to load
-// into the default bundle. Register before first use of DefaultBundle.
+// into the default bundle. See AddDefaultBundleCustomizer.
 func AddDefaultLocaleFromFS(fsys fs.FS, path string) {
 	AddDefaultBundleCustomizer(func(b *i18n.Bundle) error {
 		_, err := b.LoadMessageFileFS(fsys, path)
@@ -62,7 +62,7 @@ func AddDefaultLocaleFromFS(fsys fs.FS, path string) {
 
 // AddDefaultMessage registers a single message for the given language.
 // lang is a BCP 47 tag (e.g. "en", "zh", "zh-TW"). It overrides or adds to the default bundle.
-// Invalid lang is ignored. Register before first use of DefaultBundle.
+// Invalid lang is ignored. See AddDefaultBundleCustomizer.
 func AddDefaultMessage(lang string, id string, template string) {
 	tag, err := language.Parse(lang)
 	if err != nil {
@@ -75,25 +75,88 @@ func AddDefaultMessage(lang string, id string, template string) {
 	})
 }
 
+// AddDefaultPseudoLocales registers the pseudo-locales PseudoAccentLang and PseudoBidiLang,
+// derived from the embedded DefaultLang locale, in the default bundle.
+// See AddDefaultBundleCustomizer.
+func AddDefaultPseudoLocales() {
+	AddDefaultBundleCustomizer(func(b *i18n.Bundle) error {
+		filePath := path.Join(DefaultLocaleDir, DefaultLang+".json")
+		buf, err := fs.ReadFile(SelectedLocalesFS, filePath)
+		if err != nil {
+			return err
+		}
+		file, err := i18n.ParseMessageFileBytes(buf, filePath, nil)
+		if err != nil {
+			return err
+		}
+		return AddPseudoMessages(b, file.Messages)
+	})
+}
+
 // DefaultBundle returns a cached bundle: first loads embedded locales (locales/*.json),
 // then runs all customizers registered via AddDefaultLocaleFile, AddDefaultLocaleFromFS,
-// and AddDefaultMessage. Safe for concurrent use.
+// and AddDefaultMessage. A failed build is not cached, so the next call retries.
+// Safe for concurrent use.
 func DefaultBundle() (*i18n.Bundle, error) {
-	defaultBundleOnce.Do(func() {
-		defaultBundle, defaultBundleErr = LoadBundleFromFS(LocalesFS, DefaultLocaleDir)
-		if defaultBundleErr != nil {
-			return
+	defaultMu.RLock()
+	bundle := defaultBundle
+	defaultMu.RUnlock()
+	if bundle != nil {
+		return bundle, nil
+	}
+	defaultMu.Lock()
+	defer defaultMu.Unlock()
+	if defaultBundle != nil {
+		return defaultBundle, nil
+	}
+	return buildDefaultBundle()
+}
+
+// RebuildDefaultBundle drops the cached default bundle and builds it again,
+// e.g. after a file registered via AddDefaultLocaleFile changed on disk.
+// Bundles returned earlier are not modified.
+func RebuildDefaultBundle() (*i18n.Bundle, error) {
+	defaultMu.Lock()
+	defer defaultMu.Unlock()
+	dropDefaultBundle()
+	return buildDefaultBundle()
+}
+
+// ResetDefaultBundle removes all registered customizers and drops the cached default bundle,
+// so the next call to DefaultBundle rebuilds it from the embedded locales only.
+// Intended for tests.
+func ResetDefaultBundle() {
+	defaultMu.Lock()
+	defer defaultMu.Unlock()
+	defaultCustomizers = nil
+	dropDefaultBundle()
+}
+
+// dropDefaultBundle must be called with defaultMu held.
+func dropDefaultBundle() {
+	if defaultBundle != nil {
+		InvalidateLocalizers(defaultBundle)
+		defaultBundle = nil
+	}
+}
+
+// buildDefaultBundle must be called with defaultMu held.
+func buildDefaultBundle() (*i18n.Bundle, error) {
+	bundle, err := catalogBundle()
+	if err != nil {
+		return nil, err
+	}
+	if bundle == nil {
+		bundle, err = LoadBundleFromFS(SelectedLocalesFS, DefaultLocaleDir)
+		if err != nil {
+			return nil, err
 		}
-		defaultCustomizersMu.Lock()
-		customizers := append([]BundleCustomizer(nil), defaultCustomizers...)
-		defaultCustomizersMu.Unlock()
-		for _, fn := range customizers {
-			if err := fn(defaultBundle); err != nil {
-				defaultBundleErr = err
-				defaultBundle = nil
-				return
-			}
+	}
+	for _, fn := range defaultCustomizers {
+		if err := fn(bundle); err != nil {
+			return nil, err
 		}
-	})
-	return defaultBundle, defaultBundleErr
+	}
+	defaultBundle = bundle
+	return bundle, nil
 }
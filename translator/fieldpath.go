@@ -0,0 +1,279 @@
+package translator
+
+import (
+	"strconv"
+	"strings"
+
+	"buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// FieldPathFormatter renders the field path of a violation for display in a language.
+type FieldPathFormatter interface {
+	FormatFieldPath(lang string, path *validate.FieldPath) string
+}
+
+// FieldPathFormatterFunc adapts a function to FieldPathFormatter.
+type FieldPathFormatterFunc func(lang string, path *validate.FieldPath) string
+
+// FormatFieldPath calls f(lang, path).
+func (f FieldPathFormatterFunc) FormatFieldPath(lang string, path *validate.FieldPath) string {
+	return f(lang, path)
+}
+
+// ProtoFieldPath renders paths with proto field names and subscripts, like
+// protovalidate.FieldPathString, e.g. `items[2].attributes["color"]`.
+var ProtoFieldPath FieldPathFormatter = FieldPathFormatterFunc(func(_ string, path *validate.FieldPath) string {
+	var b strings.Builder
+	for i, element := range path.GetElements() {
+		if i > 0 {
+			b.WriteByte('.')
+		}
+		b.WriteString(element.GetFieldName())
+		if key, ok := subscript(element); ok {
+			b.WriteString("[" + key + "]")
+		}
+	}
+	return b.String()
+})
+
+// JSONFieldPath renders paths like ProtoFieldPath but with the protojson names of
+// the fields, e.g. "items[2].address.zipCode", resolved by walking desc, the
+// descriptor of the validated message. Elements that cannot be resolved keep their name.
+func JSONFieldPath(desc protoreflect.MessageDescriptor) FieldPathFormatter {
+	return FieldPathFormatterFunc(func(_ string, path *validate.FieldPath) string {
+		var b strings.Builder
+		md := desc
+		for i, element := range path.GetElements() {
+			if i > 0 {
+				b.WriteByte('.')
+			}
+			b.WriteString(jsonName(element, md))
+			if key, ok := subscript(element); ok {
+				b.WriteString("[" + key + "]")
+			}
+			md = nextMessage(element, md)
+		}
+		return b.String()
+	})
+}
+
+// fieldDescriptor returns the field of desc that element refers to, or nil.
+func fieldDescriptor(element *validate.FieldPathElement, desc protoreflect.MessageDescriptor) protoreflect.FieldDescriptor {
+	if desc == nil {
+		return nil
+	}
+	name := element.GetFieldName()
+	if strings.HasPrefix(name, "[") && strings.HasSuffix(name, "]") {
+		xt, err := protoregistry.GlobalTypes.FindExtensionByName(protoreflect.FullName(name[1 : len(name)-1]))
+		if err != nil {
+			return nil
+		}
+		return xt.TypeDescriptor()
+	}
+	if element.HasFieldNumber() {
+		return desc.Fields().ByNumber(protoreflect.FieldNumber(element.GetFieldNumber()))
+	}
+	return desc.Fields().ByName(protoreflect.Name(name))
+}
+
+// nextMessage returns the message that the element after element belongs to, or nil.
+func nextMessage(element *validate.FieldPathElement, desc protoreflect.MessageDescriptor) protoreflect.MessageDescriptor {
+	fd := fieldDescriptor(element, desc)
+	switch {
+	case fd == nil:
+		return nil
+	case fd.IsMap():
+		return fd.MapValue().Message()
+	default:
+		return fd.Message()
+	}
+}
+
+// jsonName returns the protojson name of element: the json_name of a field, or
+// the bracketed full name of an extension.
+func jsonName(element *validate.FieldPathElement, desc protoreflect.MessageDescriptor) string {
+	fd := fieldDescriptor(element, desc)
+	switch {
+	case fd == nil:
+		return element.GetFieldName()
+	case fd.IsExtension():
+		return "[" + string(fd.FullName()) + "]"
+	default:
+		return fd.JSONName()
+	}
+}
+
+// subscript returns the list index or map key of element, with string keys quoted.
+func subscript(element *validate.FieldPathElement) (string, bool) {
+	switch element.WhichSubscript() {
+	case validate.FieldPathElement_Index_case:
+		return strconv.FormatUint(element.GetIndex(), 10), true
+	case validate.FieldPathElement_BoolKey_case:
+		return strconv.FormatBool(element.GetBoolKey()), true
+	case validate.FieldPathElement_IntKey_case:
+		return strconv.FormatInt(element.GetIntKey(), 10), true
+	case validate.FieldPathElement_UintKey_case:
+		return strconv.FormatUint(element.GetUintKey(), 10), true
+	case validate.FieldPathElement_StringKey_case:
+		return strconv.Quote(element.GetStringKey()), true
+	default:
+		return "", false
+	}
+}
+
+// Message IDs used by FieldPathRenderer. The label of a field is the message
+// LabelPrefix + its full name, e.g. "label.shop.Address.zip_code", or else
+// LabelPrefix + its proto name, e.g. "label.zip_code". Full names are only
+// known with WithMessageDescriptor; short labels are shared by all fields of that name.
+const (
+	LabelPrefix          = "label."
+	FieldPathSeparatorID = "field_path.separator"
+	FieldPathIndexID     = "field_path.index"
+	FieldPathKeyID       = "field_path.key"
+)
+
+// FieldNames selects how FieldPathRenderer names fields without a label.
+type FieldNames int
+
+const (
+	// ProtoNames uses the names of the .proto file, e.g. "zip_code".
+	ProtoNames FieldNames = iota
+	// JSONNames uses the protojson names, e.g. "zipCode".
+	JSONNames
+)
+
+// FieldPathOption configures a FieldPathRenderer.
+type FieldPathOption func(*FieldPathRenderer)
+
+// WithOneBasedIndex numbers list elements from 1 instead of 0.
+func WithOneBasedIndex() FieldPathOption {
+	return func(r *FieldPathRenderer) {
+		r.oneBased = true
+	}
+}
+
+// WithFieldNames sets how fields without a label are named. The default is ProtoNames.
+func WithFieldNames(names FieldNames) FieldPathOption {
+	return func(r *FieldPathRenderer) {
+		r.names = names
+	}
+}
+
+// WithMessageDescriptor resolves the protojson names used by JSONNames through
+// desc, the descriptor of the validated message, so that json_name options are
+// honored. Without it, names are derived from the proto names.
+func WithMessageDescriptor(desc protoreflect.MessageDescriptor) FieldPathOption {
+	return func(r *FieldPathRenderer) {
+		r.desc = desc
+	}
+}
+
+// WithPathFallback sets the language of labels and path messages missing in the
+// requested language. The default is DefaultLang; "" disables the fallback.
+func WithPathFallback(lang string) FieldPathOption {
+	return func(r *FieldPathRenderer) {
+		r.fallback = lang
+	}
+}
+
+// FieldPathRenderer renders field paths for end users, e.g.
+// "Items › #3 › Address › ZIP code" for items[2].address.zip_code. Fields are
+// named by their label message, list indices and map keys by the
+// FieldPathIndexID and FieldPathKeyID messages, and elements are joined with the
+// FieldPathSeparatorID message. Safe for concurrent use.
+type FieldPathRenderer struct {
+	bundle   *i18n.Bundle
+	fallback string
+	oneBased bool
+	names    FieldNames
+	desc     protoreflect.MessageDescriptor
+}
+
+// NewFieldPathRenderer returns a renderer using the messages of bundle, or of
+// DefaultBundle when bundle is nil.
+func NewFieldPathRenderer(bundle *i18n.Bundle, opts ...FieldPathOption) *FieldPathRenderer {
+	r := &FieldPathRenderer{bundle: bundle, fallback: DefaultLang}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// FormatFieldPath renders path in lang.
+func (r *FieldPathRenderer) FormatFieldPath(lang string, path *validate.FieldPath) string {
+	bundle := r.bundle
+	if bundle == nil {
+		// Without a bundle, the built-in defaults below are used.
+		bundle, _ = DefaultBundle()
+	}
+	messages := func(ids []string, data map[string]any, def string) string {
+		if bundle == nil {
+			return def
+		}
+		for _, l := range []string{lang, r.fallback} {
+			for _, id := range ids {
+				if msg, _, ok, err := localize(bundle, l, id, data); ok && err == nil {
+					return msg
+				}
+			}
+		}
+		return def
+	}
+	message := func(id string, data map[string]any, def string) string {
+		return messages([]string{id}, data, def)
+	}
+
+	var parts []string
+	desc := r.desc
+	for _, element := range path.GetElements() {
+		name := element.GetFieldName()
+		def := name
+		labels := []string{LabelPrefix + name}
+		if fd := fieldDescriptor(element, desc); fd != nil {
+			labels = []string{LabelPrefix + string(fd.FullName()), LabelPrefix + name}
+			if r.names == JSONNames {
+				def = jsonName(element, desc)
+			}
+		} else if r.names == JSONNames {
+			def = jsonCamelCase(name)
+		}
+		desc = nextMessage(element, desc)
+		parts = append(parts, messages(labels, nil, def))
+		switch element.WhichSubscript() {
+		case validate.FieldPathElement_Subscript_not_set_case:
+		case validate.FieldPathElement_Index_case:
+			index := element.GetIndex()
+			if r.oneBased {
+				index++
+			}
+			parts = append(parts, message(FieldPathIndexID, map[string]any{"Index": index}, "#"+strconv.FormatUint(index, 10)))
+		default:
+			key, _ := subscript(element)
+			if element.WhichSubscript() == validate.FieldPathElement_StringKey_case {
+				key = element.GetStringKey()
+			}
+			parts = append(parts, message(FieldPathKeyID, map[string]any{"Key": key}, strconv.Quote(key)))
+		}
+	}
+	return strings.Join(parts, message(FieldPathSeparatorID, nil, " › "))
+}
+
+// jsonCamelCase returns the default protojson name of a proto field name.
+func jsonCamelCase(s string) string {
+	var b strings.Builder
+	underscore := false
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c != '_' {
+			if underscore && 'a' <= c && c <= 'z' {
+				c -= 'a' - 'A'
+			}
+			b.WriteByte(c)
+		}
+		underscore = c == '_'
+	}
+	return b.String()
+}
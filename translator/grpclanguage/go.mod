@@ -0,0 +1,22 @@
+module github.com/jzero-io/protovalidate-translator/translator/grpclanguage
+
+go 1.24.3
+
+replace github.com/jzero-io/protovalidate-translator => ../../
+
+require (
+	github.com/jzero-io/protovalidate-translator v0.0.0
+	google.golang.org/grpc v1.71.0
+)
+
+require (
+	buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go v1.36.10-20251209175733-2a1774d88802.1 // indirect
+	github.com/BurntSushi/toml v1.6.0 // indirect
+	github.com/nicksnyder/go-i18n/v2 v2.6.1 // indirect
+	golang.org/x/net v0.43.0 // indirect
+	golang.org/x/sys v0.35.0 // indirect
+	golang.org/x/text v0.32.0 // indirect
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20250811230008-5f3141c8851a // indirect
+	google.golang.org/protobuf v1.36.10 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
@@ -0,0 +1,57 @@
+// Package grpclanguage carries the language of gRPC requests in their contexts,
+// like translator.LanguageHandler does for HTTP.
+package grpclanguage
+
+import (
+	"context"
+	"strings"
+
+	"github.com/jzero-io/protovalidate-translator/translator"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// MetadataKey is the metadata key with the language of a request, in the form of
+// an Accept-Language header value. gRPC gateways forward the HTTP header under it.
+const MetadataKey = "accept-language"
+
+// FromIncomingContext returns the language in the incoming metadata of ctx, or "".
+// Multiple values are joined as in an Accept-Language header.
+func FromIncomingContext(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	return strings.Join(md.Get(MetadataKey), ",")
+}
+
+// newContext returns ctx carrying the language of its incoming metadata, if any.
+func newContext(ctx context.Context) context.Context {
+	if lang := FromIncomingContext(ctx); lang != "" {
+		return translator.WithLanguage(ctx, lang)
+	}
+	return ctx
+}
+
+// UnaryServerInterceptor returns an interceptor that makes request contexts carry
+// the language of their metadata (see translator.WithLanguage).
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		return handler(newContext(ctx), req)
+	}
+}
+
+// StreamServerInterceptor is like UnaryServerInterceptor for streaming RPCs.
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &serverStream{ServerStream: ss, ctx: newContext(ss.Context())})
+	}
+}
+
+// serverStream is a grpc.ServerStream with a replaced context.
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context { return s.ctx }
@@ -0,0 +1,169 @@
+// Command gencatalog compiles locale files into Go source for the translator package.
+//
+// For every <lang>.json in -dir it writes catalog_<lang>_gen.go to -out, guarded by
+// the translator_catalog build tag and, except for the -default language, by the
+// translator_select_locales and translator_locale_<lang> tags. Each file registers
+// the messages of its language as Go literals, and templates made of text and
+// {{.Field}} actions pre-split into parts.
+//
+// Usage:
+//
+//	go run ./internal/gencatalog -dir locales -out .
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+	"golang.org/x/text/language"
+)
+
+const (
+	buildTag  = "translator_catalog"
+	selectTag = "translator_select_locales"
+)
+
+var fieldAction = regexp.MustCompile(`^\{\{\s*\.([A-Za-z_][A-Za-z0-9_]*)\s*\}\}$`)
+
+func main() {
+	dir := flag.String("dir", "locales", "directory with <lang>.json locale files")
+	out := flag.String("out", ".", "output directory")
+	pkg := flag.String("pkg", "translator", "package name of the generated files")
+	defaultLang := flag.String("default", "en", "language that is always compiled in")
+	flag.Parse()
+
+	files, err := filepath.Glob(filepath.Join(*dir, "*.json"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	if len(files) == 0 {
+		log.Fatalf("no locale files found in %s", *dir)
+	}
+	stale, err := filepath.Glob(filepath.Join(*out, "catalog_*_gen.go"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, name := range stale {
+		if err := os.Remove(name); err != nil {
+			log.Fatal(err)
+		}
+	}
+	for _, name := range files {
+		if err := generate(name, *out, *pkg, *defaultLang); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+func generate(name string, out string, pkg string, defaultLang string) error {
+	buf, err := os.ReadFile(name)
+	if err != nil {
+		return err
+	}
+	file, err := i18n.ParseMessageFileBytes(buf, name, nil)
+	if err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+	lang := strings.TrimSuffix(filepath.Base(name), ".json")
+	if _, err := language.Parse(lang); err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+	msgs := file.Messages
+	sort.Slice(msgs, func(i, j int) bool { return msgs[i].ID < msgs[j].ID })
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by gencatalog from %s. DO NOT EDIT.\n\n", filepath.ToSlash(name))
+	suffix := strings.ToLower(strings.ReplaceAll(lang, "-", "_"))
+	constraint := buildTag
+	if lang != defaultLang {
+		constraint = fmt.Sprintf("%s && (!%s || translator_locale_%s)", buildTag, selectTag, suffix)
+	}
+	fmt.Fprintf(&b, "//go:build %s\n\npackage %s\n\n", constraint, pkg)
+	b.WriteString("import \"github.com/nicksnyder/go-i18n/v2/i18n\"\n\n")
+	b.WriteString("func init() {\n")
+	fmt.Fprintf(&b, "registerCatalog(%q, []*i18n.Message{\n", lang)
+	for _, msg := range msgs {
+		b.WriteString(messageLiteral(msg))
+	}
+	b.WriteString("}, map[string][]templatePart{\n")
+	seen := map[string]bool{}
+	for _, msg := range msgs {
+		for _, src := range []string{msg.Zero, msg.One, msg.Two, msg.Few, msg.Many, msg.Other} {
+			if seen[src] || (msg.LeftDelim != "" && msg.LeftDelim != "{{") {
+				continue
+			}
+			seen[src] = true
+			if parts, ok := splitTemplate(src); ok {
+				fmt.Fprintf(&b, "%q: {%s},\n", src, strings.Join(parts, ", "))
+			}
+		}
+	}
+	b.WriteString("})\n}\n")
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+	target := filepath.Join(out, "catalog_"+suffix+"_gen.go")
+	return os.WriteFile(target, src, 0o644)
+}
+
+func messageLiteral(msg *i18n.Message) string {
+	fields := []string{fmt.Sprintf("ID: %q", msg.ID)}
+	for _, f := range []struct{ name, value string }{
+		{"Description", msg.Description},
+		{"LeftDelim", msg.LeftDelim},
+		{"RightDelim", msg.RightDelim},
+		{"Zero", msg.Zero},
+		{"One", msg.One},
+		{"Two", msg.Two},
+		{"Few", msg.Few},
+		{"Many", msg.Many},
+		{"Other", msg.Other},
+	} {
+		if f.value != "" {
+			fields = append(fields, fmt.Sprintf("%s: %q", f.name, f.value))
+		}
+	}
+	return "{" + strings.Join(fields, ", ") + "},\n"
+}
+
+// splitTemplate splits src into templatePart literals. It reports false for templates
+// without actions, which need no execution, and for actions other than {{.Field}}.
+func splitTemplate(src string) ([]string, bool) {
+	if !strings.Contains(src, "{{") {
+		return nil, false
+	}
+	var parts []string
+	for src != "" {
+		start := strings.Index(src, "{{")
+		if start < 0 {
+			parts = append(parts, fmt.Sprintf("{text: %q}", src))
+			break
+		}
+		if start > 0 {
+			parts = append(parts, fmt.Sprintf("{text: %q}", src[:start]))
+		}
+		end := strings.Index(src[start:], "}}")
+		if end < 0 {
+			return nil, false
+		}
+		action := src[start : start+end+2]
+		m := fieldAction.FindStringSubmatch(action)
+		if m == nil {
+			return nil, false
+		}
+		parts = append(parts, fmt.Sprintf("{field: %q}", m[1]))
+		src = src[start+end+2:]
+	}
+	return parts, true
+}
@@ -0,0 +1,18 @@
+//go:build !translator_select_locales
+
+package translator
+
+import (
+	"embed"
+	"io/fs"
+)
+
+// LocalesFS embeds the default locale files. Builds with LocaleSelectBuildTag embed
+// only the DefaultLang locale in it; SelectedLocalesFS holds the selected languages.
+//
+//go:embed locales/*.json
+var LocalesFS embed.FS
+
+// SelectedLocalesFS holds the locale files of the languages embedded in this build,
+// under DefaultLocaleDir: every language, or those selected with LocaleSelectBuildTag.
+var SelectedLocalesFS fs.FS = LocalesFS
@@ -0,0 +1,15 @@
+//go:build translator_select_locales
+
+package translator
+
+import "embed"
+
+// LocalesFS embeds the default locale files. Builds with LocaleSelectBuildTag embed
+// only the DefaultLang locale in it; SelectedLocalesFS holds the selected languages.
+//
+//go:embed locales/en.json
+var LocalesFS embed.FS
+
+func init() {
+	addEmbeddedLocale(DefaultLang+".json", LocalesFS)
+}
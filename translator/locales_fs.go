@@ -0,0 +1,107 @@
+//go:build translator_select_locales
+
+package translator
+
+import (
+	"embed"
+	"io"
+	"io/fs"
+	"path"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// SelectedLocalesFS holds the locale files of the languages embedded in this build,
+// under DefaultLocaleDir: every language, or those selected with LocaleSelectBuildTag.
+var SelectedLocalesFS fs.FS = embeddedLocales
+
+// localeFS is the union of the per-language embedded locale files, all under DefaultLocaleDir.
+type localeFS struct {
+	mu    sync.RWMutex
+	files map[string]embed.FS
+}
+
+// addEmbeddedLocale is called by the per-language embed files.
+func addEmbeddedLocale(name string, fsys embed.FS) {
+	embeddedLocales.mu.Lock()
+	defer embeddedLocales.mu.Unlock()
+	embeddedLocales.files[name] = fsys
+}
+
+var embeddedLocales = &localeFS{files: map[string]embed.FS{}}
+
+func (f *localeFS) Open(name string) (fs.File, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrInvalid}
+	}
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	if name == "." || name == DefaultLocaleDir {
+		// Every embedded FS has the directories; any of them provides their FileInfo.
+		for _, fsys := range f.files {
+			dir, err := fsys.Open(name)
+			if err != nil {
+				return nil, err
+			}
+			return &localeDir{File: dir, fsys: f, name: name}, nil
+		}
+	}
+	dir, file := path.Split(name)
+	if fsys, ok := f.files[file]; ok && strings.TrimSuffix(dir, "/") == DefaultLocaleDir {
+		return fsys.Open(name)
+	}
+	return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
+}
+
+func (f *localeFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	if name != "." && name != DefaultLocaleDir {
+		return nil, &fs.PathError{Op: "readdir", Path: name, Err: fs.ErrNotExist}
+	}
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	var entries []fs.DirEntry
+	for _, fsys := range f.files {
+		all, err := fsys.ReadDir(name)
+		if err != nil {
+			return nil, err
+		}
+		if name == "." {
+			return all, nil
+		}
+		entries = append(entries, all...)
+	}
+	slices.SortFunc(entries, func(a, b fs.DirEntry) int { return strings.Compare(a.Name(), b.Name()) })
+	return entries, nil
+}
+
+// localeDir is a directory of localeFS; reading it lists the union.
+type localeDir struct {
+	fs.File
+	fsys    *localeFS
+	name    string
+	entries []fs.DirEntry
+	read    bool
+}
+
+func (d *localeDir) ReadDir(n int) ([]fs.DirEntry, error) {
+	if !d.read {
+		entries, err := d.fsys.ReadDir(d.name)
+		if err != nil {
+			return nil, err
+		}
+		d.entries, d.read = entries, true
+	}
+	if n <= 0 {
+		entries := d.entries
+		d.entries = nil
+		return entries, nil
+	}
+	if len(d.entries) == 0 {
+		return nil, io.EOF
+	}
+	n = min(n, len(d.entries))
+	entries := d.entries[:n]
+	d.entries = d.entries[n:]
+	return entries, nil
+}
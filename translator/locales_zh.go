@@ -0,0 +1,12 @@
+//go:build translator_select_locales && translator_locale_zh
+
+package translator
+
+import "embed"
+
+//go:embed locales/zh.json
+var localeZh embed.FS
+
+func init() {
+	addEmbeddedLocale("zh.json", localeZh)
+}
@@ -0,0 +1,12 @@
+//go:build translator_select_locales && translator_locale_zh_tw
+
+package translator
+
+import "embed"
+
+//go:embed locales/zh-TW.json
+var localeZhTw embed.FS
+
+func init() {
+	addEmbeddedLocale("zh-TW.json", localeZhTw)
+}
@@ -0,0 +1,125 @@
+package translator
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+	"golang.org/x/text/language"
+)
+
+const (
+	// maxCachedBundles bounds the number of bundles with cached localizers, with
+	// room for a TenantRegistry of the default size; the least recently used bundle
+	// is dropped first.
+	maxCachedBundles = DefaultTenantCacheSize + 16
+	// maxCachedLangs bounds the cached localizers per bundle, since lang may be
+	// an arbitrary Accept-Language header value.
+	maxCachedLangs = 256
+)
+
+// localizerCache caches go-i18n localizers per bundle and language.
+// A localizer reads the bundle's messages at lookup time, so it stays valid when
+// messages are added to the bundle; entries only need dropping to release bundles.
+type localizerCache struct {
+	mu      sync.RWMutex
+	bundles map[*i18n.Bundle]*bundleLocalizers
+	// clock orders the uses of bundles for eviction.
+	clock atomic.Uint64
+}
+
+// bundleLocalizers are the localizers of a bundle; used is the clock of its last use.
+type bundleLocalizers struct {
+	langs map[string]*cachedLocalizer
+	used  atomic.Uint64
+}
+
+// cachedLocalizer is a localizer with the language it resolves to and how well
+// that language matches the requested one.
+type cachedLocalizer struct {
+	*i18n.Localizer
+	resolved   language.Tag
+	tag        string
+	confidence language.Confidence
+	// ntags is the number of bundle languages when the entry was made; adding
+	// a language may change what lang resolves to.
+	ntags int
+}
+
+var localizers = &localizerCache{bundles: map[*i18n.Bundle]*bundleLocalizers{}}
+
+func (c *localizerCache) get(bundle *i18n.Bundle, lang string) *cachedLocalizer {
+	var localizer *cachedLocalizer
+	c.mu.RLock()
+	if entry := c.bundles[bundle]; entry != nil {
+		entry.used.Store(c.clock.Add(1))
+		localizer = entry.langs[lang]
+	}
+	c.mu.RUnlock()
+	if localizer != nil && localizer.ntags == len(bundle.LanguageTags()) {
+		return localizer
+	}
+	localizer = newCachedLocalizer(bundle, lang)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry := c.bundles[bundle]
+	if entry == nil {
+		if len(c.bundles) >= maxCachedBundles {
+			c.evict()
+		}
+		entry = &bundleLocalizers{langs: map[string]*cachedLocalizer{}}
+		entry.used.Store(c.clock.Add(1))
+		c.bundles[bundle] = entry
+	}
+	if cached := entry.langs[lang]; cached != nil && cached.ntags == localizer.ntags {
+		return cached
+	}
+	if _, ok := entry.langs[lang]; ok || len(entry.langs) < maxCachedLangs {
+		entry.langs[lang] = localizer
+	}
+	return localizer
+}
+
+// evict drops the least recently used bundle. It must be called with c.mu held.
+func (c *localizerCache) evict() {
+	var (
+		oldest *i18n.Bundle
+		used   uint64
+	)
+	for bundle, entry := range c.bundles {
+		if u := entry.used.Load(); oldest == nil || u < used {
+			oldest, used = bundle, u
+		}
+	}
+	delete(c.bundles, oldest)
+}
+
+func newCachedLocalizer(bundle *i18n.Bundle, lang string) *cachedLocalizer {
+	localizer := &cachedLocalizer{
+		Localizer: i18n.NewLocalizer(bundle, lang),
+		ntags:     len(bundle.LanguageTags()),
+	}
+	if tag, ok := resolveTag(localizer.Localizer); ok {
+		localizer.resolved, localizer.tag = tag, tag.String()
+	}
+	// go-i18n matches with a matcher built from the bundle languages in the same way.
+	if tags, _, err := language.ParseAcceptLanguage(lang); err == nil {
+		_, _, localizer.confidence = language.NewMatcher(bundle.LanguageTags()).Match(tags...)
+	}
+	return localizer
+}
+
+func (c *localizerCache) invalidate(bundle *i18n.Bundle) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.bundles, bundle)
+}
+
+// InvalidateLocalizers drops the localizers cached for bundle, so that a bundle
+// that is no longer used can be garbage collected. Bundles replaced by
+// ReloadingBundle, RebuildDefaultBundle, ResetDefaultBundle and TenantRegistry
+// are dropped automatically.
+func InvalidateLocalizers(bundle *i18n.Bundle) {
+	localizers.invalidate(bundle)
+}
@@ -0,0 +1,84 @@
+package translator
+
+import (
+	"sort"
+	"sync"
+	"sync/atomic"
+)
+
+// MissingHandler is called with the message id and the requested language
+// whenever a language tried during translation has no message for id.
+// It is called even if a fallback language then supplies the message.
+// It must be safe for concurrent use.
+type MissingHandler func(id string, lang string)
+
+var missingHandler atomic.Pointer[MissingHandler]
+
+// SetMissingHandler installs the handler called for missing messages. Passing nil removes it.
+func SetMissingHandler(fn MissingHandler) {
+	if fn == nil {
+		missingHandler.Store(nil)
+		return
+	}
+	missingHandler.Store(&fn)
+}
+
+func reportMissing(id string, lang string) {
+	if fn := missingHandler.Load(); fn != nil {
+		(*fn)(id, lang)
+	}
+}
+
+// MissingCollector collects missing message IDs per language, e.g. for backfilling locale files.
+// Install it with SetMissingHandler(collector.Record). Safe for concurrent use.
+type MissingCollector struct {
+	mu      sync.Mutex
+	missing map[string]map[string]int
+}
+
+// NewMissingCollector returns an empty collector.
+func NewMissingCollector() *MissingCollector {
+	return &MissingCollector{missing: map[string]map[string]int{}}
+}
+
+// Record counts id as missing in lang. It is a MissingHandler.
+func (c *MissingCollector) Record(id string, lang string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	ids := c.missing[lang]
+	if ids == nil {
+		ids = map[string]int{}
+		c.missing[lang] = ids
+	}
+	ids[id]++
+}
+
+// Missing returns the sorted missing IDs per language.
+func (c *MissingCollector) Missing() map[string][]string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	out := make(map[string][]string, len(c.missing))
+	for lang, ids := range c.missing {
+		list := make([]string, 0, len(ids))
+		for id := range ids {
+			list = append(list, id)
+		}
+		sort.Strings(list)
+		out[lang] = list
+	}
+	return out
+}
+
+// Count returns how often id was reported missing in lang.
+func (c *MissingCollector) Count(lang string, id string) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.missing[lang][id]
+}
+
+// Reset clears all collected IDs.
+func (c *MissingCollector) Reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.missing = map[string]map[string]int{}
+}
@@ -0,0 +1,74 @@
+package translator
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+)
+
+// Event describes a single translation call, as reported to an Observer.
+type Event struct {
+	// ID is the message (rule) id.
+	ID string
+	// Lang is the requested language: the first non-empty language of the chain.
+	Lang string
+	// ResolvedLang is the bundle language that supplied the message, "" if missing.
+	ResolvedLang string
+	// FallbackDepth is the position in the fallback chain of the language that
+	// supplied the message: 0 for the requested language, 1 for the first fallback, and so on.
+	FallbackDepth int
+	// Missing reports whether no language had the message.
+	Missing bool
+	// Start is when the call started; Duration is how long it took.
+	Start    time.Time
+	Duration time.Duration
+	// Err is the error returned to the caller, if any.
+	Err error
+}
+
+// Observer receives an Event for every call to Translate, TranslateChain, TranslateCtx,
+// TranslateDefault and their Result and Must variants. ctx is the context passed to
+// TranslateCtx, or context.Background(). Implementations must be safe for concurrent use.
+type Observer interface {
+	ObserveTranslation(ctx context.Context, e Event)
+}
+
+// ObserverFunc adapts a function to an Observer.
+type ObserverFunc func(ctx context.Context, e Event)
+
+// ObserveTranslation calls f(ctx, e).
+func (f ObserverFunc) ObserveTranslation(ctx context.Context, e Event) {
+	f(ctx, e)
+}
+
+// MultiObserver returns an Observer that notifies each of observers in order.
+func MultiObserver(observers ...Observer) Observer {
+	observers = append([]Observer(nil), observers...)
+	return ObserverFunc(func(ctx context.Context, e Event) {
+		for _, o := range observers {
+			o.ObserveTranslation(ctx, e)
+		}
+	})
+}
+
+type observerHolder struct {
+	Observer
+}
+
+var observer atomic.Pointer[observerHolder]
+
+// SetObserver installs the observer notified of translation calls. Passing nil removes it.
+func SetObserver(o Observer) {
+	if o == nil {
+		observer.Store(nil)
+		return
+	}
+	observer.Store(&observerHolder{o})
+}
+
+func currentObserver() Observer {
+	if h := observer.Load(); h != nil {
+		return h.Observer
+	}
+	return nil
+}
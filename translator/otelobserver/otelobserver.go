@@ -0,0 +1,77 @@
+// Package otelobserver records translator.Observer events as OpenTelemetry spans.
+package otelobserver
+
+import (
+	"context"
+
+	"github.com/jzero-io/protovalidate-translator/translator"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ScopeName is the instrumentation scope of the tracer.
+const ScopeName = "github.com/jzero-io/protovalidate-translator/translator"
+
+// SpanName is the name of the span recorded for each translation call.
+const SpanName = "translator.Translate"
+
+// Span attribute keys.
+const (
+	RuleIDKey        = attribute.Key("translator.rule_id")
+	LangKey          = attribute.Key("translator.lang")
+	ResolvedLangKey  = attribute.Key("translator.resolved_lang")
+	FallbackDepthKey = attribute.Key("translator.fallback_depth")
+	MissingKey       = attribute.Key("translator.missing")
+)
+
+// Option configures an Observer.
+type Option func(*Observer)
+
+// WithTracerProvider sets the tracer provider. The default is otel.GetTracerProvider().
+func WithTracerProvider(tp trace.TracerProvider) Option {
+	return func(o *Observer) {
+		o.provider = tp
+	}
+}
+
+// Observer is a translator.Observer that records a span per translation call,
+// as a child of the span in the context passed to translator.TranslateCtx.
+type Observer struct {
+	provider trace.TracerProvider
+	tracer   trace.Tracer
+}
+
+// New returns an Observer. Install it with translator.SetObserver.
+func New(opts ...Option) *Observer {
+	o := &Observer{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	if o.provider == nil {
+		o.provider = otel.GetTracerProvider()
+	}
+	o.tracer = o.provider.Tracer(ScopeName)
+	return o
+}
+
+// ObserveTranslation implements translator.Observer.
+func (o *Observer) ObserveTranslation(ctx context.Context, e translator.Event) {
+	_, span := o.tracer.Start(ctx, SpanName,
+		trace.WithTimestamp(e.Start),
+		trace.WithSpanKind(trace.SpanKindInternal),
+		trace.WithAttributes(
+			RuleIDKey.String(e.ID),
+			LangKey.String(e.Lang),
+			ResolvedLangKey.String(e.ResolvedLang),
+			FallbackDepthKey.Int(e.FallbackDepth),
+			MissingKey.Bool(e.Missing),
+		),
+	)
+	if e.Err != nil {
+		span.RecordError(e.Err)
+		span.SetStatus(codes.Error, e.Err.Error())
+	}
+	span.End(trace.WithTimestamp(e.Start.Add(e.Duration)))
+}
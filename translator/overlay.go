@@ -0,0 +1,146 @@
+package translator
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+	"github.com/nicksnyder/go-i18n/v2/i18n/template"
+)
+
+// Layer is a named bundle in an Overlay.
+type Layer struct {
+	Name   string
+	Bundle *i18n.Bundle
+}
+
+// Overlay composes bundles into layers without modifying them, e.g. shipped defaults,
+// then company-wide overrides, then per-service overrides. Later layers take precedence:
+// a message is looked up in every layer, top-down, for the requested language before
+// any layer is consulted for the fallback language. Layers whose languages match the
+// requested language more closely are consulted first, so a lower layer with zh-TW
+// answers a zh-TW request before an upper layer that only has zh. Safe for concurrent use.
+type Overlay struct {
+	mu     sync.RWMutex
+	layers []Layer
+}
+
+// NewOverlay returns an overlay with the given layers, lowest precedence first.
+func NewOverlay(layers ...Layer) *Overlay {
+	return &Overlay{layers: append([]Layer(nil), layers...)}
+}
+
+// Push adds a layer on top of the overlay. If a layer with the same name exists,
+// its bundle is replaced and it keeps its position.
+func (o *Overlay) Push(name string, bundle *i18n.Bundle) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	for i := range o.layers {
+		if o.layers[i].Name == name {
+			o.layers[i].Bundle = bundle
+			return
+		}
+	}
+	o.layers = append(o.layers, Layer{Name: name, Bundle: bundle})
+}
+
+// Remove removes the named layer and reports whether it existed.
+func (o *Overlay) Remove(name string) bool {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	for i := range o.layers {
+		if o.layers[i].Name == name {
+			o.layers = append(o.layers[:i:i], o.layers[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// Layers returns a copy of the layers, lowest precedence first.
+func (o *Overlay) Layers() []Layer {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	return append([]Layer(nil), o.layers...)
+}
+
+// Translate is like Translate, consulting the layers from top to bottom.
+func (o *Overlay) Translate(lang string, defaultLang string, id string, data map[string]any) (string, error) {
+	res, err := o.TranslateResult(lang, defaultLang, id, data)
+	return res.Message, err
+}
+
+// TranslateResult is like TranslateResult, consulting the layers from top to bottom.
+func (o *Overlay) TranslateResult(lang string, defaultLang string, id string, data map[string]any) (Result, error) {
+	return o.translateChain(context.Background(), []string{lang, defaultLang}, id, data)
+}
+
+// translateChain is like translateChain, consulting the layers from top to bottom.
+func (o *Overlay) translateChain(ctx context.Context, langs []string, id string, data map[string]any) (Result, error) {
+	return observe(ctx, langs, id, func() (Result, error) {
+		return lookupLayers(o.Layers(), langs, id, data)
+	})
+}
+
+// lookupLayers is like lookupChain, trying every layer for a language before the next language.
+func lookupLayers(layers []Layer, langs []string, id string, data map[string]any) (Result, error) {
+	tried := 0
+	for i, lang := range langs {
+		if lang == "" || slices.Contains(langs[:i], lang) {
+			continue
+		}
+		for _, layer := range layerOrder(layers, lang) {
+			msg, tag, ok, err := localize(layer.Bundle, lang, id, data)
+			if err != nil {
+				return Result{}, err
+			}
+			if ok {
+				return Result{Message: msg, Lang: tag, Fallback: tried > 0, FallbackDepth: tried}, nil
+			}
+		}
+		reportMissing(id, lang)
+		tried++
+	}
+	return Result{Message: id, Missing: true}, nil
+}
+
+// LayerFor reports the name of the layer Translate takes the message for id in lang
+// from before falling back. It is meant for debugging overrides: it does not render
+// the message and is not reported to the Observer.
+func (o *Overlay) LayerFor(lang string, id string) (string, bool) {
+	if lang == "" {
+		return "", false
+	}
+	for _, layer := range layerOrder(o.Layers(), lang) {
+		if hasMessage(layer.Bundle, lang, id) {
+			return layer.Name, true
+		}
+	}
+	return "", false
+}
+
+// layerOrder returns the layers with a bundle in the order they are consulted for
+// lang: by how well their languages match lang, then top-down.
+func layerOrder(layers []Layer, lang string) []Layer {
+	order := make([]Layer, 0, len(layers))
+	for i := len(layers) - 1; i >= 0; i-- {
+		if layers[i].Bundle != nil {
+			order = append(order, layers[i])
+		}
+	}
+	slices.SortStableFunc(order, func(a, b Layer) int {
+		return cmp.Compare(localizers.get(b.Bundle, lang).confidence, localizers.get(a.Bundle, lang).confidence)
+	})
+	return order
+}
+
+// hasMessage reports whether bundle has a message for id in lang without executing its template.
+func hasMessage(bundle *i18n.Bundle, lang string, id string) bool {
+	_, err := localizers.get(bundle, lang).Localize(&i18n.LocalizeConfig{
+		MessageID:      id,
+		TemplateParser: template.IdentityParser{},
+	})
+	return err == nil
+}
@@ -0,0 +1,168 @@
+// Package promobserver exports translator.Observer events as Prometheus metrics.
+package promobserver
+
+import (
+	"context"
+
+	"github.com/jzero-io/protovalidate-translator/translator"
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/text/language"
+)
+
+// Result label values of the translations counter.
+const (
+	ResultTranslated = "translated"
+	ResultFallback   = "fallback"
+	ResultMissing    = "missing"
+	ResultError      = "error"
+)
+
+// LangOther is the lang label value of requested languages that match none of
+// the languages given to WithLanguages.
+const LangOther = "other"
+
+// Option configures an Observer.
+type Option func(*options)
+
+type options struct {
+	namespace string
+	buckets   []float64
+	languages []language.Tag
+}
+
+// WithNamespace sets the metric namespace. The default is "protovalidate_translator".
+func WithNamespace(namespace string) Option {
+	return func(o *options) {
+		o.namespace = namespace
+	}
+}
+
+// WithBuckets sets the buckets of the duration histogram, in seconds.
+func WithBuckets(buckets ...float64) Option {
+	return func(o *options) {
+		o.buckets = buckets
+	}
+}
+
+// WithLanguages labels requested languages with the closest of langs, usually the
+// languages of the bundle, and with LangOther when none matches. By default the
+// base language of the request is used, e.g. "zh" for "zh-TW,zh;q=0.9".
+func WithLanguages(langs ...language.Tag) Option {
+	return func(o *options) {
+		o.languages = langs
+	}
+}
+
+// Observer is a translator.Observer and a prometheus.Collector with these metrics:
+//
+//   - translations_total{rule_id, lang, resolved_lang, result}: translation calls,
+//     where result is one of ResultTranslated, ResultFallback, ResultMissing or ResultError;
+//   - translation_duration_seconds{lang}: latency of translation calls;
+//   - fallback_depth{lang}: position in the fallback chain of the supplying language.
+//
+// Requested languages and rule IDs may come from clients, so to bound the number
+// of series lang is normalized as described for WithLanguages, and the rule_id of
+// missing messages is ResultMissing.
+type Observer struct {
+	translations  *prometheus.CounterVec
+	duration      *prometheus.HistogramVec
+	fallbackDepth *prometheus.HistogramVec
+
+	languages []language.Tag
+	matcher   language.Matcher
+}
+
+// New returns an Observer. Register it with a prometheus.Registerer and install it
+// with translator.SetObserver.
+func New(opts ...Option) *Observer {
+	o := options{
+		namespace: "protovalidate_translator",
+		buckets:   []float64{.00001, .000025, .00005, .0001, .00025, .0005, .001, .0025, .005, .01},
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	var matcher language.Matcher
+	if len(o.languages) > 0 {
+		matcher = language.NewMatcher(o.languages)
+	}
+	return &Observer{
+		translations: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: o.namespace,
+			Name:      "translations_total",
+			Help:      "Number of translation calls by rule ID, requested and resolved language, and result.",
+		}, []string{"rule_id", "lang", "resolved_lang", "result"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: o.namespace,
+			Name:      "translation_duration_seconds",
+			Help:      "Latency of translation calls by requested language.",
+			Buckets:   o.buckets,
+		}, []string{"lang"}),
+		fallbackDepth: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: o.namespace,
+			Name:      "fallback_depth",
+			Help:      "Position in the fallback chain of the language that supplied the message.",
+			Buckets:   []float64{0, 1, 2, 3},
+		}, []string{"lang"}),
+		languages: o.languages,
+		matcher:   matcher,
+	}
+}
+
+// ObserveTranslation implements translator.Observer.
+func (o *Observer) ObserveTranslation(_ context.Context, e translator.Event) {
+	id := e.ID
+	if e.Missing {
+		id = ResultMissing
+	}
+	lang := o.langLabel(e.Lang)
+	o.translations.WithLabelValues(id, lang, e.ResolvedLang, result(e)).Inc()
+	o.duration.WithLabelValues(lang).Observe(e.Duration.Seconds())
+	if e.Err == nil && !e.Missing {
+		o.fallbackDepth.WithLabelValues(lang).Observe(float64(e.FallbackDepth))
+	}
+}
+
+// langLabel returns the lang label value of a requested language.
+func (o *Observer) langLabel(lang string) string {
+	tags, _, err := language.ParseAcceptLanguage(lang)
+	if err != nil || len(tags) == 0 {
+		tags = []language.Tag{language.Und}
+	}
+	if o.matcher == nil {
+		base, _ := tags[0].Base()
+		return base.String()
+	}
+	_, i, confidence := o.matcher.Match(tags...)
+	if confidence == language.No {
+		return LangOther
+	}
+	return o.languages[i].String()
+}
+
+// Describe implements prometheus.Collector.
+func (o *Observer) Describe(ch chan<- *prometheus.Desc) {
+	o.translations.Describe(ch)
+	o.duration.Describe(ch)
+	o.fallbackDepth.Describe(ch)
+}
+
+// Collect implements prometheus.Collector.
+func (o *Observer) Collect(ch chan<- prometheus.Metric) {
+	o.translations.Collect(ch)
+	o.duration.Collect(ch)
+	o.fallbackDepth.Collect(ch)
+}
+
+func result(e translator.Event) string {
+	switch {
+	case e.Err != nil:
+		return ResultError
+	case e.Missing:
+		return ResultMissing
+	case e.FallbackDepth > 0:
+		return ResultFallback
+	default:
+		return ResultTranslated
+	}
+}
@@ -0,0 +1,142 @@
+package translator
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+	"golang.org/x/text/language"
+)
+
+const (
+	// PseudoAccentLang is the pseudo-locale with accented and expanded text,
+	// used to catch hard-coded strings and truncation.
+	PseudoAccentLang = "en-XA"
+	// PseudoBidiLang is the pseudo-locale with right-to-left text,
+	// used to catch layout issues in mirrored UIs.
+	PseudoBidiLang = "ar-XB"
+)
+
+const (
+	bidiRLM = "\u200f"
+	bidiRLO = "\u202e"
+	bidiPDF = "\u202c"
+)
+
+var pseudoAccents = map[rune]string{
+	'a': "ȧ", 'b': "ƀ", 'c': "ƈ", 'd': "ḓ", 'e': "ḗ", 'f': "ƒ", 'g': "ɠ", 'h': "ħ", 'i': "ī",
+	'j': "ĵ", 'k': "ķ", 'l': "ŀ", 'm': "ḿ", 'n': "ƞ", 'o': "ǿ", 'p': "ƥ", 'q': "ɋ", 'r': "ř",
+	's': "ş", 't': "ŧ", 'u': "ŭ", 'v': "ṽ", 'w': "ẇ", 'x': "ẋ", 'y': "ẏ", 'z': "ẑ",
+	'A': "Ȧ", 'B': "Ɓ", 'C': "Ƈ", 'D': "Ḓ", 'E': "Ḗ", 'F': "Ƒ", 'G': "Ɠ", 'H': "Ħ", 'I': "Ī",
+	'J': "Ĵ", 'K': "Ķ", 'L': "Ŀ", 'M': "Ḿ", 'N': "Ƞ", 'O': "Ǿ", 'P': "Ƥ", 'Q': "Ɋ", 'R': "Ř",
+	'S': "Ş", 'T': "Ŧ", 'U': "Ŭ", 'V': "Ṽ", 'W': "Ẇ", 'X': "Ẋ", 'Y': "Ẏ", 'Z': "Ẑ",
+}
+
+// PseudoLocalize rewrites a message template for the given pseudo-locale.
+// PseudoAccentLang accents every letter, doubles vowels and wraps the text in brackets;
+// PseudoBidiLang wraps every word in right-to-left override marks.
+// Template actions such as {{.Value}} are kept verbatim. Other languages return template unchanged.
+func PseudoLocalize(lang string, template string) string {
+	return pseudoLocalize(lang, template, "{{", "}}")
+}
+
+// AddPseudoMessages derives PseudoAccentLang and PseudoBidiLang messages from msgs
+// (usually the DefaultLang messages) and adds them to bundle.
+func AddPseudoMessages(bundle *i18n.Bundle, msgs []*i18n.Message) error {
+	for _, lang := range []string{PseudoAccentLang, PseudoBidiLang} {
+		pseudo := make([]*i18n.Message, 0, len(msgs))
+		for _, msg := range msgs {
+			pseudo = append(pseudo, pseudoMessage(lang, msg))
+		}
+		if err := bundle.AddMessages(language.MustParse(lang), pseudo...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func pseudoMessage(lang string, msg *i18n.Message) *i18n.Message {
+	left, right := msg.LeftDelim, msg.RightDelim
+	if left == "" {
+		left = "{{"
+	}
+	if right == "" {
+		right = "}}"
+	}
+	out := *msg
+	for _, field := range []*string{&out.Zero, &out.One, &out.Two, &out.Few, &out.Many, &out.Other} {
+		if *field != "" {
+			*field = pseudoLocalize(lang, *field, left, right)
+		}
+	}
+	return &out
+}
+
+func pseudoLocalize(lang string, template string, left string, right string) string {
+	var transform func(string) string
+	switch lang {
+	case PseudoAccentLang:
+		transform = pseudoAccent
+	case PseudoBidiLang:
+		transform = pseudoBidi
+	default:
+		return template
+	}
+	var b strings.Builder
+	rest := template
+	for rest != "" {
+		start := strings.Index(rest, left)
+		if start < 0 {
+			b.WriteString(transform(rest))
+			break
+		}
+		end := strings.Index(rest[start+len(left):], right)
+		if end < 0 {
+			b.WriteString(transform(rest))
+			break
+		}
+		end += start + len(left) + len(right)
+		b.WriteString(transform(rest[:start]))
+		b.WriteString(rest[start:end])
+		rest = rest[end:]
+	}
+	if lang == PseudoAccentLang {
+		return "[" + b.String() + "]"
+	}
+	return bidiRLM + b.String()
+}
+
+func pseudoAccent(text string) string {
+	var b strings.Builder
+	for _, r := range text {
+		accented, ok := pseudoAccents[r]
+		if !ok {
+			b.WriteRune(r)
+			continue
+		}
+		b.WriteString(accented)
+		if strings.ContainsRune("aeiouAEIOU", r) {
+			b.WriteString(accented)
+		}
+	}
+	return b.String()
+}
+
+func pseudoBidi(text string) string {
+	var b strings.Builder
+	inWord := false
+	for _, r := range text {
+		word := !unicode.IsSpace(r) && !unicode.IsPunct(r)
+		if word && !inWord {
+			b.WriteString(bidiRLO)
+		} else if !word && inWord {
+			b.WriteString(bidiPDF)
+		}
+		inWord = word
+		b.WriteRune(r)
+	}
+	if inWord {
+		b.WriteString(bidiPDF)
+	}
+	return b.String()
+}
@@ -0,0 +1,207 @@
+package translator
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+)
+
+// DefaultPollInterval is how often a ReloadingBundle checks its directory for changes.
+const DefaultPollInterval = 2 * time.Second
+
+// WatchOption configures a ReloadingBundle.
+type WatchOption func(*ReloadingBundle)
+
+// WithPollInterval sets how often the directory is checked for changes.
+// A non-positive interval disables polling; call Reload to pick up changes.
+func WithPollInterval(d time.Duration) WatchOption {
+	return func(r *ReloadingBundle) {
+		r.interval = d
+	}
+}
+
+// WithLoadOptions sets the options passed to LoadBundleFromDir on every (re)load.
+func WithLoadOptions(opts ...LoadOption) WatchOption {
+	return func(r *ReloadingBundle) {
+		r.loadOpts = append(r.loadOpts, opts...)
+	}
+}
+
+// OnReload registers a callback invoked with the new bundle after each successful reload.
+func OnReload(fn func(b *i18n.Bundle)) WatchOption {
+	return func(r *ReloadingBundle) {
+		r.onReload = fn
+	}
+}
+
+// OnReloadError registers a callback invoked when a reload fails. The previous bundle is kept.
+func OnReloadError(fn func(err error)) WatchOption {
+	return func(r *ReloadingBundle) {
+		r.onError = fn
+	}
+}
+
+// ReloadingBundle serves a bundle loaded from a locale directory and reloads it
+// when the files in the directory change. Safe for concurrent use.
+type ReloadingBundle struct {
+	dir      string
+	interval time.Duration
+	loadOpts []LoadOption
+	onReload func(b *i18n.Bundle)
+	onError  func(err error)
+
+	bundle atomic.Pointer[i18n.Bundle]
+
+	mu        sync.Mutex
+	stamp     string
+	stop      chan struct{}
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// WatchBundleDir loads dir like LoadBundleFromDir and keeps polling it for changes.
+// Templates are validated before a new bundle replaces the current one.
+// The initial load must succeed; call Close to stop watching.
+func WatchBundleDir(dir string, opts ...WatchOption) (*ReloadingBundle, error) {
+	r := &ReloadingBundle{
+		dir:      dir,
+		interval: DefaultPollInterval,
+		loadOpts: []LoadOption{WithTemplateValidation()},
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	stamp, err := dirStamp(dir, r.loadOpts)
+	if err != nil {
+		return nil, err
+	}
+	bundle, err := LoadBundleFromDir(dir, r.loadOpts...)
+	if err != nil {
+		return nil, err
+	}
+	r.bundle.Store(bundle)
+	r.stamp = stamp
+	if r.interval > 0 {
+		go r.poll()
+	} else {
+		close(r.done)
+	}
+	return r, nil
+}
+
+// Bundle returns the current bundle.
+func (r *ReloadingBundle) Bundle() *i18n.Bundle {
+	return r.bundle.Load()
+}
+
+// Translate is like Translate using the current bundle.
+func (r *ReloadingBundle) Translate(lang string, defaultLang string, id string, data map[string]any) (string, error) {
+	return Translate(r.Bundle(), lang, defaultLang, id, data)
+}
+
+// Reload re-reads the directory and swaps in the new bundle.
+// On error the current bundle is kept and the error is returned.
+func (r *ReloadingBundle) Reload() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stamp, err := dirStamp(r.dir, r.loadOpts)
+	if err != nil {
+		return r.failed(err)
+	}
+	return r.reload(stamp)
+}
+
+// Close stops watching the directory. The current bundle remains usable.
+func (r *ReloadingBundle) Close() error {
+	r.closeOnce.Do(func() {
+		close(r.stop)
+	})
+	<-r.done
+	return nil
+}
+
+func (r *ReloadingBundle) poll() {
+	defer close(r.done)
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-r.stop:
+			return
+		case <-ticker.C:
+			r.check()
+		}
+	}
+}
+
+func (r *ReloadingBundle) check() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stamp, err := dirStamp(r.dir, r.loadOpts)
+	if err != nil {
+		stamp = "!" + err.Error()
+	}
+	if stamp == r.stamp {
+		return
+	}
+	if err != nil {
+		r.stamp = stamp
+		_ = r.failed(err)
+		return
+	}
+	_ = r.reload(stamp)
+}
+
+// reload must be called with r.mu held.
+func (r *ReloadingBundle) reload(stamp string) error {
+	// Remember the state even on failure so a broken file is reported once, not on every tick.
+	r.stamp = stamp
+	bundle, err := LoadBundleFromDir(r.dir, r.loadOpts...)
+	if err != nil {
+		return r.failed(err)
+	}
+	if old := r.bundle.Swap(bundle); old != nil {
+		InvalidateLocalizers(old)
+	}
+	if r.onReload != nil {
+		r.onReload(bundle)
+	}
+	return nil
+}
+
+func (r *ReloadingBundle) failed(err error) error {
+	err = fmt.Errorf("reload %s: %w", r.dir, err)
+	if r.onError != nil {
+		r.onError(err)
+	}
+	return err
+}
+
+// dirStamp fingerprints the locale files in dir by name, size and modification time.
+func dirStamp(dir string, opts []LoadOption) (string, error) {
+	if _, err := os.ReadDir(dir); err != nil {
+		return "", err
+	}
+	fsys := os.DirFS(dir)
+	names, err := listLocaleFiles(fsys, ".", newLoadOptions(opts))
+	if err != nil {
+		return "", err
+	}
+	parts := make([]string, 0, len(names))
+	for _, name := range names {
+		info, err := fs.Stat(fsys, name)
+		if err != nil {
+			return "", err
+		}
+		parts = append(parts, fmt.Sprintf("%s:%d:%d", name, info.Size(), info.ModTime().UnixNano()))
+	}
+	return strings.Join(parts, "|"), nil
+}
@@ -0,0 +1,53 @@
+package translator
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+	"github.com/nicksnyder/go-i18n/v2/i18n/template"
+	"golang.org/x/text/language"
+)
+
+// staticParser returns messages without template actions as they are, so they are
+// rendered without executing a template; other messages are parsed by next, or by
+// text/template when next is nil. go-i18n caches the parsed template on the message,
+// so a message overridden with AddMessages is parsed again on its first use.
+type staticParser struct {
+	next template.Parser
+}
+
+func (staticParser) Cacheable() bool { return true }
+
+func (p staticParser) Parse(src, leftDelim, rightDelim string) (template.ParsedTemplate, error) {
+	left := leftDelim
+	if left == "" {
+		left = "{{"
+	}
+	if !strings.Contains(src, left) {
+		return staticTemplate(src), nil
+	}
+	if p.next != nil {
+		return p.next.Parse(src, leftDelim, rightDelim)
+	}
+	return (&template.TextParser{}).Parse(src, leftDelim, rightDelim)
+}
+
+// staticTemplate is a message without template actions.
+type staticTemplate string
+
+func (t staticTemplate) Execute(any) (string, error) { return string(t), nil }
+
+// resolveTag returns the bundle language go-i18n uses for lang.
+func resolveTag(localizer *i18n.Localizer) (language.Tag, bool) {
+	// Looking up an id that does not exist reports the matched language.
+	_, _, err := localizer.LocalizeWithTag(&i18n.LocalizeConfig{
+		MessageID:      "\x00",
+		TemplateParser: template.IdentityParser{},
+	})
+	var notFound *i18n.MessageNotFoundErr
+	if errors.As(err, &notFound) {
+		return notFound.Tag, true
+	}
+	return language.Und, false
+}
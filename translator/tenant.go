@@ -0,0 +1,229 @@
+package translator
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+)
+
+// DefaultTenantCacheSize is the number of tenant bundles a TenantRegistry keeps
+// when no capacity is given.
+const DefaultTenantCacheSize = 128
+
+type tenantKey struct{}
+
+// WithTenant returns a copy of ctx carrying the tenant used by TenantRegistry.
+func WithTenant(ctx context.Context, tenant string) context.Context {
+	return context.WithValue(ctx, tenantKey{}, tenant)
+}
+
+// TenantFromContext returns the tenant stored by WithTenant.
+func TenantFromContext(ctx context.Context) (string, bool) {
+	tenant, ok := ctx.Value(tenantKey{}).(string)
+	return tenant, ok && tenant != ""
+}
+
+// TenantLoader loads the bundle with a tenant's customised messages.
+// It returns a nil bundle and no error when the tenant has no customisations.
+type TenantLoader func(tenant string) (*i18n.Bundle, error)
+
+// TenantDirLoader returns a TenantLoader that loads root/<tenant> with LoadBundleFromDir.
+// Tenants without a directory have no customisations.
+func TenantDirLoader(root string, opts ...LoadOption) TenantLoader {
+	return func(tenant string) (*i18n.Bundle, error) {
+		if err := checkTenantName(tenant); err != nil {
+			return nil, err
+		}
+		dir := filepath.Join(root, tenant)
+		if _, err := os.Stat(dir); errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return LoadBundleFromDir(dir, opts...)
+	}
+}
+
+// TenantFSLoader returns a TenantLoader that loads root/<tenant> in fsys with LoadBundleFromFS.
+// Tenants without a directory have no customisations.
+func TenantFSLoader(fsys fs.FS, root string, opts ...LoadOption) TenantLoader {
+	return func(tenant string) (*i18n.Bundle, error) {
+		if err := checkTenantName(tenant); err != nil {
+			return nil, err
+		}
+		dir := path.Join(root, tenant)
+		if _, err := fs.Stat(fsys, dir); errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return LoadBundleFromFS(fsys, dir, opts...)
+	}
+}
+
+func checkTenantName(tenant string) error {
+	if !fs.ValidPath(tenant) || tenant == "." || strings.ContainsAny(tenant, `/\`) {
+		return fmt.Errorf("invalid tenant %q", tenant)
+	}
+	return nil
+}
+
+// TenantRegistry resolves the tenant from a context and translates with the tenant's
+// customised messages layered over a global bundle. Tenant bundles are loaded lazily,
+// once for concurrent first requests, and the least recently used ones are evicted.
+// Safe for concurrent use.
+type TenantRegistry struct {
+	global   *i18n.Bundle
+	load     TenantLoader
+	capacity int
+
+	mu      sync.Mutex
+	lru     *list.List
+	entries map[string]*list.Element
+	loading map[string]*tenantLoad
+}
+
+type tenantEntry struct {
+	tenant  string
+	overlay *Overlay
+}
+
+// tenantLoad is a load in progress; done is closed when overlay and err are set.
+// A load evicted while in progress is not cached.
+type tenantLoad struct {
+	done    chan struct{}
+	overlay *Overlay
+	err     error
+	evicted bool
+}
+
+// NewTenantRegistry returns a registry that falls back to global for tenants without
+// customisations and for message IDs a tenant does not override. It keeps at most
+// capacity tenants; a non-positive capacity means DefaultTenantCacheSize.
+func NewTenantRegistry(global *i18n.Bundle, load TenantLoader, capacity int) *TenantRegistry {
+	if capacity <= 0 {
+		capacity = DefaultTenantCacheSize
+	}
+	return &TenantRegistry{
+		global:   global,
+		load:     load,
+		capacity: capacity,
+		lru:      list.New(),
+		entries:  map[string]*list.Element{},
+		loading:  map[string]*tenantLoad{},
+	}
+}
+
+// Overlay returns the overlay for the tenant in ctx: the global bundle, topped by the
+// tenant's bundle if it has one. Without a tenant in ctx only the global bundle is used.
+// Load errors are returned and not cached.
+func (r *TenantRegistry) Overlay(ctx context.Context) (*Overlay, error) {
+	tenant, ok := TenantFromContext(ctx)
+	if !ok {
+		return NewOverlay(Layer{Name: "global", Bundle: r.global}), nil
+	}
+	r.mu.Lock()
+	if elem, ok := r.entries[tenant]; ok {
+		r.lru.MoveToFront(elem)
+		r.mu.Unlock()
+		return elem.Value.(*tenantEntry).overlay, nil
+	}
+	if load, ok := r.loading[tenant]; ok {
+		r.mu.Unlock()
+		select {
+		case <-load.done:
+			return load.overlay, load.err
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	load := &tenantLoad{done: make(chan struct{})}
+	r.loading[tenant] = load
+	r.mu.Unlock()
+
+	load.overlay, load.err = r.loadOverlay(tenant)
+
+	r.mu.Lock()
+	if r.loading[tenant] == load {
+		delete(r.loading, tenant)
+	}
+	if load.err == nil && !load.evicted {
+		r.entries[tenant] = r.lru.PushFront(&tenantEntry{tenant: tenant, overlay: load.overlay})
+		for r.lru.Len() > r.capacity {
+			r.remove(r.lru.Back())
+		}
+	}
+	r.mu.Unlock()
+	close(load.done)
+	return load.overlay, load.err
+}
+
+func (r *TenantRegistry) loadOverlay(tenant string) (*Overlay, error) {
+	bundle, err := r.load(tenant)
+	if err != nil {
+		return nil, fmt.Errorf("load tenant %q: %w", tenant, err)
+	}
+	overlay := NewOverlay(Layer{Name: "global", Bundle: r.global})
+	if bundle != nil {
+		overlay.Push(tenant, bundle)
+	}
+	return overlay, nil
+}
+
+// Translate is like Translate using the overlay for the tenant in ctx.
+func (r *TenantRegistry) Translate(ctx context.Context, lang string, defaultLang string, id string, data map[string]any) (string, error) {
+	return r.translateChain(ctx, []string{lang, defaultLang}, id, data)
+}
+
+// TranslateCtx is like TranslateCtx using the overlay for the tenant in ctx
+// instead of the bundle in ctx.
+func (r *TenantRegistry) TranslateCtx(ctx context.Context, id string, data map[string]any) (string, error) {
+	return r.translateChain(ctx, append([]string{LanguageFromContext(ctx)}, FallbackFromContext(ctx)...), id, data)
+}
+
+func (r *TenantRegistry) translateChain(ctx context.Context, langs []string, id string, data map[string]any) (string, error) {
+	overlay, err := r.Overlay(ctx)
+	if err != nil {
+		return "", err
+	}
+	res, err := overlay.translateChain(ctx, langs, id, data)
+	return res.Message, err
+}
+
+// Evict drops the cached bundle of tenant, so it is loaded again on next use.
+// A load of tenant in progress is not cached.
+func (r *TenantRegistry) Evict(tenant string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if load, ok := r.loading[tenant]; ok {
+		load.evicted = true
+		delete(r.loading, tenant)
+	}
+	if elem, ok := r.entries[tenant]; ok {
+		r.remove(elem)
+	}
+}
+
+// remove must be called with r.mu held.
+func (r *TenantRegistry) remove(elem *list.Element) {
+	entry := elem.Value.(*tenantEntry)
+	r.lru.Remove(elem)
+	delete(r.entries, entry.tenant)
+	for _, layer := range entry.overlay.Layers() {
+		if layer.Bundle != r.global {
+			InvalidateLocalizers(layer.Bundle)
+		}
+	}
+}
+
+// Len returns the number of cached tenants.
+func (r *TenantRegistry) Len() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.lru.Len()
+}
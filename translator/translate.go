@@ -1,30 +1,116 @@
 package translator
 
 import (
+	"context"
 	"errors"
+	"slices"
+	"time"
 
 	"github.com/nicksnyder/go-i18n/v2/i18n"
 )
 
+// Result describes the outcome of a translation.
+type Result struct {
+	// Message is the rendered message, or the id when Missing is true.
+	Message string
+	// Lang is the bundle language that supplied the message, "" when Missing is true.
+	// It may differ from the requested language through language matching (e.g. zh-TW -> zh).
+	Lang string
+	// Fallback reports whether the message came from a fallback language
+	// instead of the first (requested) language.
+	Fallback bool
+	// FallbackDepth is the position in the fallback chain of the language that
+	// supplied the message: 0 for the requested language, 1 for the first fallback, and so on.
+	FallbackDepth int
+	// Missing reports whether no language had the message.
+	Missing bool
+}
+
 // Translate renders a message by id using the specified language and optional fallback language.
 // If the message is missing in both languages, the id itself is returned.
 func Translate(bundle *i18n.Bundle, lang string, defaultLang string, id string, data map[string]any) (string, error) {
-	if bundle == nil {
-		return id, nil
+	res, err := TranslateResult(bundle, lang, defaultLang, id, data)
+	return res.Message, err
+}
+
+// TranslateResult is like Translate but reports which language supplied the message
+// and whether a fallback was used or the message was missing.
+func TranslateResult(bundle *i18n.Bundle, lang string, defaultLang string, id string, data map[string]any) (Result, error) {
+	return TranslateChainResult(bundle, []string{lang, defaultLang}, id, data)
+}
+
+// TranslateChain renders a message by id using the first language in langs that has it.
+// Empty and repeated languages are skipped. If no language has the message, the id itself is returned.
+func TranslateChain(bundle *i18n.Bundle, langs []string, id string, data map[string]any) (string, error) {
+	res, err := TranslateChainResult(bundle, langs, id, data)
+	return res.Message, err
+}
+
+// TranslateChainResult is like TranslateChain but returns a Result.
+// The MissingHandler, if set, is called for every language tried that lacks the message.
+func TranslateChainResult(bundle *i18n.Bundle, langs []string, id string, data map[string]any) (Result, error) {
+	return translateChain(context.Background(), bundle, langs, id, data)
+}
+
+// translateChain implements TranslateChainResult and reports the call to the Observer.
+func translateChain(ctx context.Context, bundle *i18n.Bundle, langs []string, id string, data map[string]any) (Result, error) {
+	return observe(ctx, langs, id, func() (Result, error) {
+		return lookupChain(bundle, langs, id, data)
+	})
+}
+
+// observe calls lookup and reports the translation of id with langs to the Observer.
+func observe(ctx context.Context, langs []string, id string, lookup func() (Result, error)) (Result, error) {
+	obs := currentObserver()
+	if obs == nil {
+		return lookup()
 	}
-	if msg, ok, err := localize(bundle, lang, id, data); err != nil {
-		return "", err
-	} else if ok {
-		return msg, nil
+	start := time.Now()
+	res, err := lookup()
+	obs.ObserveTranslation(ctx, newEvent(langs, id, res, err, start, time.Since(start)))
+	return res, err
+}
+
+// newEvent returns the Observer event of a translation of id with langs.
+func newEvent(langs []string, id string, res Result, err error, start time.Time, duration time.Duration) Event {
+	event := Event{
+		ID:            id,
+		ResolvedLang:  res.Lang,
+		FallbackDepth: res.FallbackDepth,
+		Missing:       res.Missing,
+		Start:         start,
+		Duration:      duration,
+		Err:           err,
+	}
+	for _, lang := range langs {
+		if lang != "" {
+			event.Lang = lang
+			break
+		}
+	}
+	return event
+}
+
+func lookupChain(bundle *i18n.Bundle, langs []string, id string, data map[string]any) (Result, error) {
+	if bundle == nil {
+		return Result{Message: id, Missing: true}, nil
 	}
-	if defaultLang != "" && defaultLang != lang {
-		if msg, ok, err := localize(bundle, defaultLang, id, data); err != nil {
-			return "", err
-		} else if ok {
-			return msg, nil
+	tried := 0
+	for i, lang := range langs {
+		if lang == "" || slices.Contains(langs[:i], lang) {
+			continue
+		}
+		msg, tag, ok, err := localize(bundle, lang, id, data)
+		if err != nil {
+			return Result{}, err
+		}
+		if ok {
+			return Result{Message: msg, Lang: tag, Fallback: tried > 0, FallbackDepth: tried}, nil
 		}
+		reportMissing(id, lang)
+		tried++
 	}
-	return id, nil
+	return Result{Message: id, Missing: true}, nil
 }
 
 // MustTranslate is like Translate but panics on error.
@@ -54,21 +140,30 @@ func MustTranslateDefault(lang string, id string, data map[string]any) string {
 	return msg
 }
 
-func localize(bundle *i18n.Bundle, lang string, id string, data map[string]any) (string, bool, error) {
+func localize(bundle *i18n.Bundle, lang string, id string, data map[string]any) (string, string, bool, error) {
 	if lang == "" {
-		return "", false, nil
+		return "", "", false, nil
 	}
-	localizer := i18n.NewLocalizer(bundle, lang)
-	msg, err := localizer.Localize(&i18n.LocalizeConfig{
-		MessageID:    id,
-		TemplateData: data,
+	return localizers.get(bundle, lang).localize(id, data)
+}
+
+// localize renders id and returns the message, the language that supplied it and
+// whether one did.
+func (localizer *cachedLocalizer) localize(id string, data map[string]any) (string, string, bool, error) {
+	msg, tag, err := localizer.LocalizeWithTag(&i18n.LocalizeConfig{
+		MessageID:      id,
+		TemplateData:   data,
+		TemplateParser: messageParser,
 	})
 	if err != nil {
 		var notFound *i18n.MessageNotFoundErr
 		if errors.As(err, &notFound) {
-			return "", false, nil
+			return "", "", false, nil
 		}
-		return "", false, err
+		return "", "", false, err
+	}
+	if tag == localizer.resolved {
+		return msg, localizer.tag, true, nil
 	}
-	return msg, true, nil
+	return msg, tag.String(), true, nil
 }
@@ -0,0 +1,157 @@
+package translator
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+
+	"buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+	"github.com/nicksnyder/go-i18n/v2/i18n/template"
+	"google.golang.org/protobuf/proto"
+)
+
+// ViolationOption configures TranslateViolations.
+type ViolationOption func(*violationOptions)
+
+type violationOptions struct {
+	fieldPaths FieldPathFormatter
+}
+
+// WithFieldPaths prefixes each translated message with the field path of its
+// violation rendered by f, e.g. "name: value length must be at least 3 characters".
+// Violations without a field path are left as they are.
+func WithFieldPaths(f FieldPathFormatter) ViolationOption {
+	return func(o *violationOptions) {
+		o.fieldPaths = f
+	}
+}
+
+// TranslateViolations returns a copy of violations, e.g. from the error details of
+// a response, with each message translated to lang or defaultLang.
+//
+// Serialized violations carry no rule values, so the template data is recovered by
+// matching the message against the DefaultLang template of its rule. Messages that
+// do not match, such as those of custom CEL rules, messages that use a field twice
+// with different values, such as the bounds of double.gt_lt, and rules without a
+// translation keep their message.
+func TranslateViolations(bundle *i18n.Bundle, lang string, defaultLang string, violations *validate.Violations, opts ...ViolationOption) (*validate.Violations, error) {
+	var o violationOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	out := proto.CloneOf(violations)
+	for i, v := range out.GetViolations() {
+		message, err := TranslateViolation(bundle, lang, defaultLang, v)
+		if err != nil {
+			return nil, fmt.Errorf("violation %d (%s): %w", i, v.GetRuleId(), err)
+		}
+		if o.fieldPaths != nil && len(v.GetField().GetElements()) > 0 {
+			message = o.fieldPaths.FormatFieldPath(lang, v.GetField()) + ": " + message
+		}
+		v.SetMessage(message)
+	}
+	return out, nil
+}
+
+// TranslateViolationsBytes is like TranslateViolations for buf.validate.Violations
+// in the protobuf wire format.
+func TranslateViolationsBytes(bundle *i18n.Bundle, lang string, defaultLang string, b []byte, opts ...ViolationOption) ([]byte, error) {
+	var violations validate.Violations
+	if err := proto.Unmarshal(b, &violations); err != nil {
+		return nil, err
+	}
+	out, err := TranslateViolations(bundle, lang, defaultLang, &violations, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(out)
+}
+
+// TranslateViolation returns the message of v translated to lang or defaultLang,
+// as described for TranslateViolations.
+func TranslateViolation(bundle *i18n.Bundle, lang string, defaultLang string, v *validate.Violation) (string, error) {
+	data, ok := violationData(bundle, v.GetRuleId(), v.GetMessage())
+	if !ok {
+		return v.GetMessage(), nil
+	}
+	res, err := TranslateResult(bundle, lang, defaultLang, v.GetRuleId(), data)
+	if err != nil {
+		return "", err
+	}
+	if res.Missing && v.GetMessage() != "" {
+		return v.GetMessage(), nil
+	}
+	return res.Message, nil
+}
+
+var (
+	// messagePatterns caches the pattern of each DefaultLang template.
+	messagePatterns sync.Map
+
+	templateAction = regexp.MustCompile(`\{\{.*?\}\}`)
+	fieldAction    = regexp.MustCompile(`^\{\{\s*\.([A-Za-z_][A-Za-z0-9_]*)\s*\}\}$`)
+)
+
+// violationPattern matches the renderings of a template; fields holds the field
+// name of each capture group.
+type violationPattern struct {
+	re     *regexp.Regexp
+	fields []string
+}
+
+// violationData matches message against the DefaultLang template of id and
+// returns the values of its {{.Field}} actions as strings. It fails when a field
+// used more than once has different values, e.g. the bounds of double.gt_lt,
+// since a translation cannot render them.
+func violationData(bundle *i18n.Bundle, id string, message string) (map[string]any, bool) {
+	if bundle == nil || id == "" {
+		return nil, false
+	}
+	src, err := localizers.get(bundle, DefaultLang).Localize(&i18n.LocalizeConfig{
+		MessageID:      id,
+		TemplateParser: template.IdentityParser{},
+	})
+	if err != nil {
+		return nil, false
+	}
+	v, ok := messagePatterns.Load(src)
+	if !ok {
+		v, _ = messagePatterns.LoadOrStore(src, messagePattern(src))
+	}
+	p := v.(*violationPattern)
+	m := p.re.FindStringSubmatch(message)
+	if m == nil {
+		return nil, false
+	}
+	data := map[string]any{}
+	for i, name := range p.fields {
+		if prev, ok := data[name]; ok && prev != m[i+1] {
+			return nil, false
+		}
+		data[name] = m[i+1]
+	}
+	return data, true
+}
+
+// messagePattern returns a pattern matching the renderings of src, with a
+// capture group for each occurrence of a {{.Field}} action.
+func messagePattern(src string) *violationPattern {
+	var b strings.Builder
+	var fields []string
+	b.WriteString("(?s)^")
+	last := 0
+	for _, loc := range templateAction.FindAllStringIndex(src, -1) {
+		b.WriteString(regexp.QuoteMeta(src[last:loc[0]]))
+		if m := fieldAction.FindStringSubmatch(src[loc[0]:loc[1]]); m != nil {
+			fields = append(fields, m[1])
+			b.WriteString("(.*?)")
+		} else {
+			b.WriteString(".*?")
+		}
+		last = loc[1]
+	}
+	b.WriteString(regexp.QuoteMeta(src[last:]) + "$")
+	return &violationPattern{re: regexp.MustCompile(b.String()), fields: fields}
+}